@@ -0,0 +1,260 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+// WorkflowRun is a run of a GitHub Actions workflow. go-github doesn't know
+// about Actions yet.
+type WorkflowRun struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	DisplayTitle string    `json:"display_title"`
+	WorkflowID   int64     `json:"workflow_id"`
+	RunNumber    int       `json:"run_number"`
+	RunAttempt   int       `json:"run_attempt"`
+	Event        string    `json:"event"`
+	Status       string    `json:"status"`
+	Conclusion   string    `json:"conclusion"`
+	HeadBranch   string    `json:"head_branch"`
+	HeadSHA      string    `json:"head_sha"`
+	HTMLURL      string    `json:"html_url"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// WorkflowJob is a job of a workflow run.
+type WorkflowJob struct {
+	ID          int64           `json:"id"`
+	RunID       int64           `json:"run_id"`
+	Name        string          `json:"name"`
+	Status      string          `json:"status"`
+	Conclusion  string          `json:"conclusion"`
+	StartedAt   *time.Time      `json:"started_at"`
+	CompletedAt *time.Time      `json:"completed_at"`
+	HTMLURL     string          `json:"html_url"`
+	Steps       []*WorkflowStep `json:"steps"`
+}
+
+// WorkflowStep is a step of a workflow job.
+type WorkflowStep struct {
+	Number      int        `json:"number"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	Conclusion  string     `json:"conclusion"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// RunListOptions filters the workflow runs ListRuns returns.
+type RunListOptions struct {
+	// Workflow is the ID or file name of a workflow.
+	Workflow string
+	Branch   string
+	// Status is a status such as in_progress or a conclusion such as
+	// failure.
+	Status string
+	// Limit is the maximum number of runs to return, or zero for all.
+	Limit int
+}
+
+// ListRuns returns the workflow runs of repo, newest first.
+func (a *API) ListRuns(repo string, opt *RunListOptions) ([]*WorkflowRun, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListRuns: %w", err)
+	}
+	path := fmt.Sprintf("repos/%s/%s/actions/runs", owner, repo)
+	if opt.Workflow != "" {
+		path = fmt.Sprintf("repos/%s/%s/actions/workflows/%s/runs", owner, repo, url.PathEscape(opt.Workflow))
+	}
+	query := url.Values{"per_page": {"100"}}
+	if opt.Limit > 0 && opt.Limit < 100 {
+		query.Set("per_page", strconv.Itoa(opt.Limit))
+	}
+	if opt.Branch != "" {
+		query.Set("branch", opt.Branch)
+	}
+	if opt.Status != "" {
+		query.Set("status", opt.Status)
+	}
+	var runs []*WorkflowRun
+	for {
+		req, err := client.NewRequest("GET", path+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("ListRuns: %w", err)
+		}
+		var page struct {
+			WorkflowRuns []*WorkflowRun `json:"workflow_runs"`
+		}
+		resp, err := client.Do(context.Background(), req, &page)
+		if err != nil {
+			return nil, fmt.Errorf("ListRuns: error retrieving runs: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListRuns: non successful response code: %s", resp.Status)
+		}
+		runs = append(runs, page.WorkflowRuns...)
+		if opt.Limit > 0 && len(runs) >= opt.Limit {
+			return runs[:opt.Limit], nil
+		}
+		if resp.NextPage == 0 {
+			return runs, nil
+		}
+		query.Set("page", strconv.Itoa(resp.NextPage))
+	}
+}
+
+func (a *API) GetRun(repo string, id int64) (*WorkflowRun, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("GetRun: %w", err)
+	}
+	req, err := client.NewRequest("GET", fmt.Sprintf("repos/%s/%s/actions/runs/%d", owner, repo, id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("GetRun: %w", err)
+	}
+	run := new(WorkflowRun)
+	resp, err := client.Do(context.Background(), req, run)
+	if err != nil {
+		return nil, fmt.Errorf("GetRun: error retrieving run %d: %w", id, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("GetRun: non successful response code: %s", resp.Status)
+	}
+	return run, nil
+}
+
+// ListRunJobs returns the jobs of the latest attempt of a workflow run.
+func (a *API) ListRunJobs(repo string, id int64) ([]*WorkflowJob, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListRunJobs: %w", err)
+	}
+	page := 1
+	var jobs []*WorkflowJob
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("repos/%s/%s/actions/runs/%d/jobs?per_page=100&page=%d", owner, repo, id, page), nil)
+		if err != nil {
+			return nil, fmt.Errorf("ListRunJobs: %w", err)
+		}
+		var result struct {
+			Jobs []*WorkflowJob `json:"jobs"`
+		}
+		resp, err := client.Do(context.Background(), req, &result)
+		if err != nil {
+			return nil, fmt.Errorf("ListRunJobs: error retrieving jobs of run %d: %w", id, err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListRunJobs: non successful response code: %s", resp.Status)
+		}
+		jobs = append(jobs, result.Jobs...)
+		if resp.NextPage == 0 {
+			return jobs, nil
+		}
+		page = resp.NextPage
+	}
+}
+
+// RunLogs returns the zip archive of the logs of a workflow run, which holds
+// a <job>/<number>_<step>.txt file per step. The caller has to close it.
+func (a *API) RunLogs(repo string, id int64) (io.ReadCloser, error) {
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("RunLogs: %w", err)
+	}
+	body, err := a.download(a.apiURL(fmt.Sprintf("repos/%s/%s/actions/runs/%d/logs", owner, repo, id)), "application/vnd.github.v3+json")
+	if err != nil {
+		return nil, fmt.Errorf("RunLogs: error downloading logs of run %d: %w", id, err)
+	}
+	return body, nil
+}
+
+// JobLogs returns the plain text log of a workflow job. The caller has to
+// close it.
+func (a *API) JobLogs(repo string, id int64) (io.ReadCloser, error) {
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("JobLogs: %w", err)
+	}
+	body, err := a.download(a.apiURL(fmt.Sprintf("repos/%s/%s/actions/jobs/%d/logs", owner, repo, id)), "application/vnd.github.v3+json")
+	if err != nil {
+		return nil, fmt.Errorf("JobLogs: error downloading logs of job %d: %w", id, err)
+	}
+	return body, nil
+}
+
+// RerunRun starts a new attempt of a completed workflow run. With failedOnly
+// only the failed jobs and the jobs depending on them are run again.
+func (a *API) RerunRun(repo string, id int64, failedOnly bool) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("RerunRun: %w", err)
+	}
+	path := fmt.Sprintf("repos/%s/%s/actions/runs/%d/rerun", owner, repo, id)
+	if failedOnly {
+		path = fmt.Sprintf("repos/%s/%s/actions/runs/%d/rerun-failed-jobs", owner, repo, id)
+	}
+	req, err := client.NewRequest("POST", path, nil)
+	if err != nil {
+		return fmt.Errorf("RerunRun: %w", err)
+	}
+	resp, err := client.Do(context.Background(), req, nil)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 403 {
+		return fmt.Errorf("RerunRun: can't rerun run %d: %s", id, errResp.Message)
+	}
+	if err != nil {
+		return fmt.Errorf("RerunRun: error rerunning run %d: %w", id, err)
+	}
+	if resp.StatusCode != 201 {
+		return fmt.Errorf("RerunRun: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+// CancelRun cancels a queued or in progress workflow run. GitHub cancels it
+// asynchronously.
+func (a *API) CancelRun(repo string, id int64) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("CancelRun: %w", err)
+	}
+	req, err := client.NewRequest("POST", fmt.Sprintf("repos/%s/%s/actions/runs/%d/cancel", owner, repo, id), nil)
+	if err != nil {
+		return fmt.Errorf("CancelRun: %w", err)
+	}
+	resp, err := client.Do(context.Background(), req, nil)
+	var accepted *github.AcceptedError
+	if errors.As(err, &accepted) {
+		return nil
+	}
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 409 {
+		return fmt.Errorf("CancelRun: can't cancel run %d: %s", id, errResp.Message)
+	}
+	if err != nil {
+		return fmt.Errorf("CancelRun: error cancelling run %d: %w", id, err)
+	}
+	return fmt.Errorf("CancelRun: non successful response code: %s", resp.Status)
+}
+
+// apiURL returns the absolute URL of an API path, for requests which don't
+// go through go-github.
+func (a *API) apiURL(path string) string {
+	client := github.NewClient(a.client)
+	return client.BaseURL.String() + path
+}
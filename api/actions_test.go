@@ -0,0 +1,123 @@
+package api_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_ListRuns(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		want := "https://api.github.com/repos/TheAlgorithms/Go/actions/workflows/ci.yml/runs?branch=main&per_page=2&status=failure"
+		if req.URL.String() != want {
+			t.Errorf("ListRuns request = %v, want %v", req.URL, want)
+		}
+		header := make(http.Header)
+		header.Set("Link", `<https://api.github.com/repos/TheAlgorithms/Go/actions/workflows/ci.yml/runs?page=2>; rel="next"`)
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"total_count": 5, "workflow_runs": [{"id": 1}, {"id": 2}]}`)),
+			Header:     header,
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListRuns("TheAlgorithms/Go", &api.RunListOptions{Workflow: "ci.yml", Branch: "main", Status: "failure", Limit: 2})
+	if err != nil {
+		t.Fatalf("ListRuns() error = %v", err)
+	}
+	if len(got) != 2 || got[1].ID != 2 {
+		t.Errorf("ListRuns() = %v", got)
+	}
+}
+
+func Test_api_ListRunJobs(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/repos/TheAlgorithms/Go/actions/runs/7/jobs" {
+			t.Errorf("ListRunJobs request = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body: ioutil.NopCloser(bytes.NewBufferString(`{"jobs": [{"id": 3, "name": "build", "status": "completed", "conclusion": "success",
+				"steps": [{"number": 1, "name": "Set up job", "status": "completed", "conclusion": "success"}]}]}`)),
+			Header: make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListRunJobs("TheAlgorithms/Go", 7)
+	if err != nil {
+		t.Fatalf("ListRunJobs() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "build" || len(got[0].Steps) != 1 || got[0].Steps[0].Name != "Set up job" {
+		t.Errorf("ListRunJobs() = %v", got)
+	}
+}
+
+func Test_api_RerunRun(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "POST" || req.URL.Path != "/repos/TheAlgorithms/Go/actions/runs/7/rerun-failed-jobs" {
+			t.Errorf("RerunRun request = %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	if err := app.RerunRun("TheAlgorithms/Go", 7, true); err != nil {
+		t.Errorf("RerunRun() error = %v", err)
+	}
+}
+
+func Test_api_CancelRun(t *testing.T) {
+	status := 202
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "POST" || req.URL.Path != "/repos/TheAlgorithms/Go/actions/runs/7/cancel" {
+			t.Errorf("CancelRun request = %v %v", req.Method, req.URL)
+		}
+		body := `{}`
+		if status == 409 {
+			body = `{"message": "Cannot cancel a workflow run that is completed."}`
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})
+	app := api.NewApi(cl)
+	if err := app.CancelRun("TheAlgorithms/Go", 7); err != nil {
+		t.Errorf("CancelRun() error = %v", err)
+	}
+	status = 409
+	err := app.CancelRun("TheAlgorithms/Go", 7)
+	if err == nil || err.Error() != "CancelRun: can't cancel run 7: Cannot cancel a workflow run that is completed." {
+		t.Errorf("CancelRun() of a completed run = %v", err)
+	}
+}
+
+func Test_api_RunLogs(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/actions/runs/7/logs" {
+			t.Errorf("RunLogs request = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("zip")),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	body, err := app.RunLogs("TheAlgorithms/Go", 7)
+	if err != nil {
+		t.Fatalf("RunLogs() error = %v", err)
+	}
+	defer body.Close()
+	if got, _ := ioutil.ReadAll(body); string(got) != "zip" {
+		t.Errorf("RunLogs() = %q", got)
+	}
+}
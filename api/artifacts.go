@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+// Artifact is a file a workflow run uploaded, kept as a zip archive until it
+// expires.
+type Artifact struct {
+	ID                 int64     `json:"id"`
+	Name               string    `json:"name"`
+	SizeInBytes        int64     `json:"size_in_bytes"`
+	ArchiveDownloadURL string    `json:"archive_download_url"`
+	Expired            bool      `json:"expired"`
+	CreatedAt          time.Time `json:"created_at"`
+	ExpiresAt          time.Time `json:"expires_at"`
+	WorkflowRun        struct {
+		ID         int64  `json:"id"`
+		HeadBranch string `json:"head_branch"`
+	} `json:"workflow_run"`
+}
+
+// ListArtifacts returns the artifacts of every workflow run of repo, newest
+// first.
+func (a *API) ListArtifacts(repo string) ([]*Artifact, error) {
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListArtifacts: %w", err)
+	}
+	artifacts, err := a.listArtifacts(fmt.Sprintf("repos/%s/%s/actions/artifacts", owner, repo))
+	if err != nil {
+		return nil, fmt.Errorf("ListArtifacts: %w", err)
+	}
+	return artifacts, nil
+}
+
+func (a *API) ListRunArtifacts(repo string, id int64) ([]*Artifact, error) {
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListRunArtifacts: %w", err)
+	}
+	artifacts, err := a.listArtifacts(fmt.Sprintf("repos/%s/%s/actions/runs/%d/artifacts", owner, repo, id))
+	if err != nil {
+		return nil, fmt.Errorf("ListRunArtifacts: %w", err)
+	}
+	return artifacts, nil
+}
+
+// listArtifacts follows the pagination links of the artifacts listed at path
+// until every artifact has been retrieved.
+func (a *API) listArtifacts(path string) ([]*Artifact, error) {
+	client := github.NewClient(a.client)
+	page := 1
+	var artifacts []*Artifact
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("%s?per_page=100&page=%d", path, page), nil)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Artifacts []*Artifact `json:"artifacts"`
+		}
+		resp, err := client.Do(context.Background(), req, &result)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving artifacts: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("non successful response code: %s", resp.Status)
+		}
+		artifacts = append(artifacts, result.Artifacts...)
+		if resp.NextPage == 0 {
+			return artifacts, nil
+		}
+		page = resp.NextPage
+	}
+}
+
+// DownloadArtifact returns the zip archive of an artifact. The caller has to
+// close it.
+func (a *API) DownloadArtifact(artifact *Artifact) (io.ReadCloser, error) {
+	if artifact.Expired {
+		return nil, fmt.Errorf("DownloadArtifact: artifact %s has expired", artifact.Name)
+	}
+	body, err := a.download(artifact.ArchiveDownloadURL, "application/vnd.github.v3+json")
+	if err != nil {
+		return nil, fmt.Errorf("DownloadArtifact: error downloading %s: %w", artifact.Name, err)
+	}
+	return body, nil
+}
@@ -0,0 +1,53 @@
+package api_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_ListRunArtifacts(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/repos/TheAlgorithms/Go/actions/runs/7/artifacts" {
+			t.Errorf("ListRunArtifacts request = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body: ioutil.NopCloser(bytes.NewBufferString(`{"total_count": 1, "artifacts": [{"id": 3, "name": "coverage", "size_in_bytes": 1500,
+				"archive_download_url": "https://api.github.com/repos/TheAlgorithms/Go/actions/artifacts/3/zip", "workflow_run": {"id": 7}}]}`)),
+			Header: make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListRunArtifacts("TheAlgorithms/Go", 7)
+	if err != nil || len(got) != 1 || got[0].Name != "coverage" || got[0].WorkflowRun.ID != 7 {
+		t.Errorf("ListRunArtifacts() = %v, %v", got, err)
+	}
+}
+
+func Test_api_DownloadArtifact(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/actions/artifacts/3/zip" {
+			t.Errorf("DownloadArtifact request = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("zip")),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	artifact := &api.Artifact{Name: "coverage", ArchiveDownloadURL: "https://api.github.com/repos/TheAlgorithms/Go/actions/artifacts/3/zip"}
+	body, err := app.DownloadArtifact(artifact)
+	if err != nil {
+		t.Fatalf("DownloadArtifact() error = %v", err)
+	}
+	body.Close()
+	artifact.Expired = true
+	if _, err := app.DownloadArtifact(artifact); err == nil {
+		t.Errorf("DownloadArtifact() of an expired artifact didn't fail")
+	}
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+// Branch is a branch of a repository with its last commit.
+type Branch struct {
+	Name      string    `json:"name"`
+	Protected bool      `json:"protected"`
+	SHA       string    `json:"sha"`
+	Subject   string    `json:"subject"`
+	Author    string    `json:"author"`
+	Date      time.Time `json:"date"`
+}
+
+type branchesResult struct {
+	Repository struct {
+		Refs struct {
+			Nodes []struct {
+				Name                 string `json:"name"`
+				BranchProtectionRule *struct {
+					Pattern string `json:"pattern"`
+				} `json:"branchProtectionRule"`
+				Target struct {
+					OID             string    `json:"oid"`
+					MessageHeadline string    `json:"messageHeadline"`
+					CommittedDate   time.Time `json:"committedDate"`
+					Author          struct {
+						Name string `json:"name"`
+						User *struct {
+							Login string `json:"login"`
+						} `json:"user"`
+					} `json:"author"`
+				} `json:"target"`
+			} `json:"nodes"`
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+		} `json:"refs"`
+	} `json:"repository"`
+}
+
+// ListBranches returns the branches of repo with their last commit, which the
+// REST API would need a request per branch for. Author is the GitHub login of
+// the commit author, or their git name when it isn't linked to a user.
+func (a *API) ListBranches(repo string) ([]*Branch, error) {
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListBranches: %w", err)
+	}
+	query := `query($owner: String!, $repo: String!, $after: String) {
+	repository(owner: $owner, name: $repo) {
+		refs(refPrefix: "refs/heads/", first: 100, after: $after) {
+			nodes {
+				name
+				branchProtectionRule { pattern }
+				target { ... on Commit { oid messageHeadline committedDate author { name user { login } } } }
+			}
+			pageInfo { hasNextPage endCursor }
+		}
+	}
+}`
+	variables := map[string]interface{}{"owner": owner, "repo": repo}
+	var branches []*Branch
+	for {
+		var result branchesResult
+		if err := a.graphQL(query, variables, &result); err != nil {
+			return nil, fmt.Errorf("ListBranches: %w", err)
+		}
+		page := result.Repository.Refs
+		for _, node := range page.Nodes {
+			branch := &Branch{
+				Name:      node.Name,
+				Protected: node.BranchProtectionRule != nil,
+				SHA:       node.Target.OID,
+				Subject:   node.Target.MessageHeadline,
+				Author:    node.Target.Author.Name,
+				Date:      node.Target.CommittedDate,
+			}
+			if node.Target.Author.User != nil {
+				branch.Author = node.Target.Author.User.Login
+			}
+			branches = append(branches, branch)
+		}
+		if !page.PageInfo.HasNextPage {
+			return branches, nil
+		}
+		variables["after"] = page.PageInfo.EndCursor
+	}
+}
+
+func (a *API) GetBranch(repo, branch string) (*github.Branch, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("GetBranch: %w", err)
+	}
+	b, resp, err := client.Repositories.GetBranch(context.Background(), owner, repo, url.PathEscape(branch))
+	if err != nil {
+		return nil, fmt.Errorf("GetBranch: error retrieving branch %s: %w", branch, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("GetBranch: non successful response code: %s", resp.Status)
+	}
+	return b, nil
+}
@@ -0,0 +1,58 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_ListBranches(t *testing.T) {
+	pages := []string{
+		`{"data": {"repository": {"refs": {
+			"nodes": [{"name": "main", "branchProtectionRule": {"pattern": "main"},
+				"target": {"oid": "abc123", "messageHeadline": "Add sort", "committedDate": "2026-10-19T10:00:00Z",
+					"author": {"name": "Octo Cat", "user": {"login": "octocat"}}}}],
+			"pageInfo": {"hasNextPage": true, "endCursor": "c1"}}}}}`,
+		`{"data": {"repository": {"refs": {
+			"nodes": [{"name": "dev", "branchProtectionRule": null,
+				"target": {"oid": "def456", "messageHeadline": "WIP", "committedDate": "2026-10-18T10:00:00Z",
+					"author": {"name": "Someone", "user": null}}}],
+			"pageInfo": {"hasNextPage": false, "endCursor": "c2"}}}}}`,
+	}
+	requests := 0
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if requests == 1 && body.Variables["after"] != "c1" {
+			t.Errorf("ListBranches second page after = %v", body.Variables["after"])
+		}
+		requests++
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(pages[requests-1])),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	branches, err := app.ListBranches("TheAlgorithms/Go")
+	if err != nil {
+		t.Fatalf("ListBranches() error = %v", err)
+	}
+	if len(branches) != 2 {
+		t.Fatalf("ListBranches() = %d branches, want 2", len(branches))
+	}
+	if b := branches[0]; b.Name != "main" || !b.Protected || b.Author != "octocat" || b.SHA != "abc123" {
+		t.Errorf("ListBranches()[0] = %+v", b)
+	}
+	if b := branches[1]; b.Protected || b.Author != "Someone" || b.Subject != "WIP" {
+		t.Errorf("ListBranches()[1] = %+v", b)
+	}
+}
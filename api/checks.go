@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/github"
+)
+
+// ListCheckRuns returns the latest check run of every check of ref, which may
+// be a SHA, branch or tag name. Check runs are reported by GitHub Apps such as
+// Actions, unlike commit statuses.
+func (a *API) ListCheckRuns(repo, ref string) ([]*github.CheckRun, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListCheckRuns: %w", err)
+	}
+	opt := github.ListCheckRunsOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	var runs []*github.CheckRun
+	for {
+		page, resp, err := client.Checks.ListCheckRunsForRef(context.Background(), owner, repo, ref, &opt)
+		if err != nil {
+			return nil, fmt.Errorf("ListCheckRuns: error retrieving check runs of %s: %w", ref, err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListCheckRuns: non successful response code: %s", resp.Status)
+		}
+		runs = append(runs, page.CheckRuns...)
+		if resp.NextPage == 0 {
+			return runs, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,38 @@
+package api_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_ListCheckRuns(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		header := make(http.Header)
+		body := `{"total_count": 2, "check_runs": [{"name": "build"}]}`
+		switch req.URL.String() {
+		case "https://api.github.com/repos/TheAlgorithms/Go/commits/abc/check-runs?per_page=100":
+			header.Set("Link", `<https://api.github.com/repos/TheAlgorithms/Go/commits/abc/check-runs?page=2&per_page=100>; rel="next"`)
+		case "https://api.github.com/repos/TheAlgorithms/Go/commits/abc/check-runs?page=2&per_page=100":
+			body = `{"total_count": 2, "check_runs": [{"name": "test"}]}`
+		default:
+			t.Errorf("unexpected request %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     header,
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListCheckRuns("TheAlgorithms/Go", "abc")
+	if err != nil {
+		t.Fatalf("ListCheckRuns() error = %v", err)
+	}
+	if len(got) != 2 || got[1].GetName() != "test" {
+		t.Errorf("ListCheckRuns() = %v", got)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -20,6 +21,42 @@ func NewApi(client *http.Client) *API {
 	}
 }
 
+// NewClient returns an http client which authenticates every request with
+// token. An empty token returns http.DefaultClient, which is enough for
+// reading public repositories.
+func NewClient(token string) *http.Client {
+	if token == "" {
+		return http.DefaultClient
+	}
+	return &http.Client{
+		Transport: &tokenTransport{token: token, base: http.DefaultTransport},
+	}
+}
+
+type tokenTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+// noTokenKey marks the context of requests which must not be authenticated.
+type noTokenKey struct{}
+
+// withoutToken returns a context for requests which must not carry the token,
+// eg downloads from the storage GitHub redirects to, which must not see it.
+func withoutToken(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noTokenKey{}, true)
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Context().Value(noTokenKey{}) != nil {
+		return t.base.RoundTrip(req)
+	}
+	// RoundTrippers must not modify the request they are given.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "token "+t.token)
+	return t.base.RoundTrip(req)
+}
+
 func (a *API) GetPR(repo, id string) (*github.PullRequest, error) {
 	client := github.NewClient(a.client)
 	owner, repo, err := getOwnerAndRepo(repo)
@@ -70,23 +107,56 @@ func getOwnerAndRepo(addr string) (owner string, repo string, err error) {
 }
 
 func (a *API) ListPRs(repo, state string) ([]*github.PullRequest, error) {
-	client := github.NewClient(a.client)
 	owner, repo, err := getOwnerAndRepo(repo)
 	if err != nil {
 		return nil, fmt.Errorf("ListPRS: %w", err)
 	}
-	prs, resp, err := client.PullRequests.List(context.Background(), owner, repo, nil)
-	if err != nil {
-		return nil, fmt.Errorf("ListPRs: error retrieving PRs: %w", err)
+	// Open PRs are listed by default.
+	query := url.Values{}
+	if state != "" && state != "open" {
+		query.Set("state", state)
 	}
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("ListPRs: non successful response code: %s", resp.Status)
+	prs, err := a.listPRs(fmt.Sprintf("repos/%s/%s/pulls", owner, repo), query, "")
+	if err != nil {
+		return nil, fmt.Errorf("ListPRs: %w", err)
 	}
 	return prs, nil
 }
 
-func (a *API) ListIssues(repo, state string) ([]*github.Issue, error) {
+// listPRs follows the pagination links of the PRs listed at path until every
+// PR has been retrieved. accept overrides the media type when it's not empty.
+func (a *API) listPRs(path string, query url.Values, accept string) ([]*github.PullRequest, error) {
 	client := github.NewClient(a.client)
+	var prs []*github.PullRequest
+	for {
+		u := path
+		if len(query) > 0 {
+			u += "?" + query.Encode()
+		}
+		req, err := client.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		var page []*github.PullRequest
+		resp, err := client.Do(context.Background(), req, &page)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving PRs: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("non successful response code: %s", resp.Status)
+		}
+		prs = append(prs, page...)
+		if resp.NextPage == 0 {
+			return prs, nil
+		}
+		query.Set("page", strconv.Itoa(resp.NextPage))
+	}
+}
+
+func (a *API) ListIssues(repo, state string) ([]*github.Issue, error) {
 	owner, repo, err := getOwnerAndRepo(repo)
 	if err != nil {
 		return nil, fmt.Errorf("ListIssues: %w", err)
@@ -94,12 +164,30 @@ func (a *API) ListIssues(repo, state string) ([]*github.Issue, error) {
 	opt := github.IssueListByRepoOptions{
 		State: state,
 	}
-	issues, resp, err := client.Issues.ListByRepo(context.TODO(), owner, repo, &opt)
+	issues, err := a.listIssues(owner, repo, &opt)
 	if err != nil {
-		return nil, fmt.Errorf("ListIssues: error retrieving issues: %w", err)
-	}
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("ListIssues: non successful response code: %s", resp.Status)
+		return nil, fmt.Errorf("ListIssues: %w", err)
 	}
 	return issues, nil
 }
+
+// listIssues follows the pagination links until every issue matching opt has
+// been retrieved. Note that GitHub treats PRs as issues too.
+func (a *API) listIssues(owner, repo string, opt *github.IssueListByRepoOptions) ([]*github.Issue, error) {
+	client := github.NewClient(a.client)
+	var issues []*github.Issue
+	for {
+		page, resp, err := client.Issues.ListByRepo(context.TODO(), owner, repo, opt)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving issues: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("non successful response code: %s", resp.Status)
+		}
+		issues = append(issues, page...)
+		if resp.NextPage == 0 {
+			return issues, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
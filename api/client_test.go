@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
@@ -311,3 +312,22 @@ func Test_api_GetIssue(t *testing.T) {
 		t.Errorf("GetPR PR Body don't match; got %v, want %v", *got.Body, *want.Body)
 	}
 }
+
+func Test_NewClient(t *testing.T) {
+	if got := api.NewClient(""); got != http.DefaultClient {
+		t.Errorf("NewClient(\"\") = %v, want http.DefaultClient", got)
+	}
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+	}))
+	defer server.Close()
+	resp, err := api.NewClient("secret").Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resp.Body.Close()
+	if gotAuth != "token secret" {
+		t.Errorf("Authorization header = %q, want %q", gotAuth, "token secret")
+	}
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/go-github/github"
+)
+
+// download requests url, which is usually an API URL redirecting to the
+// storage the file is kept in, and returns the body of the response. The
+// caller has to close it. The token is only sent to url itself.
+func (a *API) download(url, accept string) (io.ReadCloser, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	noRedirect := *a.client
+	noRedirect.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	resp, err := noRedirect.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if location := resp.Header.Get("Location"); location != "" && resp.StatusCode >= 300 && resp.StatusCode < 400 {
+		resp.Body.Close()
+		req, err = http.NewRequestWithContext(withoutToken(context.Background()), "GET", location, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err = a.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode != 200 {
+		defer resp.Body.Close()
+		if err := github.CheckResponse(resp); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("non successful response code: %s", resp.Status)
+	}
+	return resp.Body, nil
+}
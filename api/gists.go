@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/github"
+)
+
+// ListGists returns the gists of the authenticated user, most recently
+// updated first.
+func (a *API) ListGists() ([]*github.Gist, error) {
+	client := github.NewClient(a.client)
+	opt := github.GistListOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	var gists []*github.Gist
+	for {
+		page, resp, err := client.Gists.List(context.Background(), "", &opt)
+		if err != nil {
+			return nil, fmt.Errorf("ListGists: error retrieving gists: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListGists: non successful response code: %s", resp.Status)
+		}
+		gists = append(gists, page...)
+		if resp.NextPage == 0 {
+			return gists, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+func (a *API) GetGist(id string) (*github.Gist, error) {
+	client := github.NewClient(a.client)
+	gist, resp, err := client.Gists.Get(context.Background(), id)
+	if err != nil {
+		return nil, fmt.Errorf("GetGist: error retrieving gist %s: %w", id, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("GetGist: non successful response code: %s", resp.Status)
+	}
+	return gist, nil
+}
+
+func (a *API) CreateGist(gist *github.Gist) (*github.Gist, error) {
+	client := github.NewClient(a.client)
+	created, resp, err := client.Gists.Create(context.Background(), gist)
+	if err != nil {
+		return nil, fmt.Errorf("CreateGist: error creating gist: %w", err)
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("CreateGist: non successful response code: %s", resp.Status)
+	}
+	return created, nil
+}
+
+// GistFileEdit changes a file of a gist. Filename renames the file.
+type GistFileEdit struct {
+	Filename string `json:"filename,omitempty"`
+	Content  string `json:"content,omitempty"`
+}
+
+// EditGist changes the files of a gist, keyed by their current name, and its
+// description unless it is nil. A nil GistFileEdit deletes the file, which
+// github.Gist can't express.
+func (a *API) EditGist(id string, description *string, files map[string]*GistFileEdit) (*github.Gist, error) {
+	client := github.NewClient(a.client)
+	body := struct {
+		Description *string                  `json:"description,omitempty"`
+		Files       map[string]*GistFileEdit `json:"files"`
+	}{description, files}
+	req, err := client.NewRequest("PATCH", "gists/"+id, &body)
+	if err != nil {
+		return nil, fmt.Errorf("EditGist: %w", err)
+	}
+	edited := new(github.Gist)
+	resp, err := client.Do(context.Background(), req, edited)
+	if err != nil {
+		return nil, fmt.Errorf("EditGist: error editing gist %s: %w", id, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("EditGist: non successful response code: %s", resp.Status)
+	}
+	return edited, nil
+}
@@ -0,0 +1,63 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_EditGist(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "PATCH" || req.URL.Path != "/gists/aa5a315d" {
+			t.Errorf("EditGist request = %v %v", req.Method, req.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		var got map[string]json.RawMessage
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := got["description"]; ok {
+			t.Errorf("EditGist sent a description: %s", body)
+		}
+		want := `{"new.go":{"filename":"renamed.go"},"old.txt":null}`
+		if string(got["files"]) != want {
+			t.Errorf("EditGist files = %s, want %s", got["files"], want)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"id": "aa5a315d"}`)),
+			Header:     make(http.Header),
+		}
+	})
+	a := api.NewApi(cl)
+	files := map[string]*api.GistFileEdit{
+		"old.txt": nil,
+		"new.go":  {Filename: "renamed.go"},
+	}
+	gist, err := a.EditGist("aa5a315d", nil, files)
+	if err != nil {
+		t.Fatalf("EditGist() error = %v", err)
+	}
+	if gist.GetID() != "aa5a315d" {
+		t.Errorf("EditGist() = %v", gist)
+	}
+}
+
+func Test_api_CreateGist(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: 422,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"message": "Validation Failed"}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})
+	a := api.NewApi(cl)
+	if _, err := a.CreateGist(nil); err == nil {
+		t.Errorf("CreateGist() didn't fail on a 422")
+	}
+}
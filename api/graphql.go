@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/github"
+)
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// graphQL runs a query or mutation against the GitHub GraphQL API, which
+// exposes a few things the REST API doesn't, and decodes its data into
+// result. result may be nil when the data isn't needed.
+func (a *API) graphQL(query string, variables map[string]interface{}, result interface{}) error {
+	client := github.NewClient(a.client)
+	req, err := client.NewRequest("POST", "graphql", &graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return err
+	}
+	var resp graphQLResponse
+	if _, err := client.Do(context.Background(), req, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		messages := make([]string, len(resp.Errors))
+		for i, e := range resp.Errors {
+			messages[i] = e.Message
+		}
+		return fmt.Errorf("%s", strings.Join(messages, "; "))
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(resp.Data, result)
+}
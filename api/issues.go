@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-github/github"
+)
+
+func (a *API) EditIssue(repo string, number int, issue *github.IssueRequest) (*github.Issue, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("EditIssue: %w", err)
+	}
+	edited, resp, err := client.Issues.Edit(context.Background(), owner, repo, number, issue)
+	if err != nil {
+		return nil, fmt.Errorf("EditIssue: error editing issue #%d: %w", number, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("EditIssue: non successful response code: %s", resp.Status)
+	}
+	return edited, nil
+}
+
+// AddLabels adds labels to an issue or PR, keeping the labels it already has.
+func (a *API) AddLabels(repo string, number int, labels []string) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("AddLabels: %w", err)
+	}
+	_, resp, err := client.Issues.AddLabelsToIssue(context.Background(), owner, repo, number, labels)
+	if err != nil {
+		return fmt.Errorf("AddLabels: error labelling #%d: %w", number, err)
+	}
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("AddLabels: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+func (a *API) RemoveLabel(repo string, number int, label string) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("RemoveLabel: %w", err)
+	}
+	resp, err := client.Issues.RemoveLabelForIssue(context.Background(), owner, repo, number, url.PathEscape(label))
+	if err != nil {
+		return fmt.Errorf("RemoveLabel: error removing label %q from #%d: %w", label, number, err)
+	}
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("RemoveLabel: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+func (a *API) AddAssignees(repo string, number int, assignees []string) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("AddAssignees: %w", err)
+	}
+	_, resp, err := client.Issues.AddAssignees(context.Background(), owner, repo, number, assignees)
+	if err != nil {
+		return fmt.Errorf("AddAssignees: error assigning #%d: %w", number, err)
+	}
+	if resp.StatusCode != 201 {
+		return fmt.Errorf("AddAssignees: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+// CreateComment comments on an issue or PR.
+func (a *API) CreateComment(repo string, number int, body string) (*github.IssueComment, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("CreateComment: %w", err)
+	}
+	comment, resp, err := client.Issues.CreateComment(context.Background(), owner, repo, number, &github.IssueComment{Body: &body})
+	if err != nil {
+		return nil, fmt.Errorf("CreateComment: error commenting on #%d: %w", number, err)
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("CreateComment: non successful response code: %s", resp.Status)
+	}
+	return comment, nil
+}
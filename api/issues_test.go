@@ -0,0 +1,53 @@
+package api_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/github"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_EditIssue(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "PATCH" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/issues/1" {
+			t.Errorf("EditIssue request = %v %v", req.Method, req.URL)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != "{\"state\":\"closed\"}\n" {
+			t.Errorf("EditIssue body = %q", body)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"number": 1, "state": "closed"}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.EditIssue("TheAlgorithms/Go", 1, &github.IssueRequest{State: github.String("closed")})
+	if err != nil {
+		t.Fatalf("EditIssue() error = %v", err)
+	}
+	if got.GetState() != "closed" {
+		t.Errorf("EditIssue() got state %q, want closed", got.GetState())
+	}
+}
+
+func Test_api_RemoveLabel(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "DELETE" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/issues/1/labels/help%20wanted" {
+			t.Errorf("RemoveLabel request = %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`[]`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	if err := app.RemoveLabel("TheAlgorithms/Go", 1, "help wanted"); err != nil {
+		t.Errorf("RemoveLabel() error = %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-github/github"
+)
+
+func (a *API) ListLabels(repo string) ([]*github.Label, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListLabels: %w", err)
+	}
+	opt := github.ListOptions{PerPage: 100}
+	var labels []*github.Label
+	for {
+		page, resp, err := client.Issues.ListLabels(context.Background(), owner, repo, &opt)
+		if err != nil {
+			return nil, fmt.Errorf("ListLabels: error retrieving labels: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListLabels: non successful response code: %s", resp.Status)
+		}
+		labels = append(labels, page...)
+		if resp.NextPage == 0 {
+			return labels, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+func (a *API) CreateLabel(repo string, label *github.Label) (*github.Label, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("CreateLabel: %w", err)
+	}
+	created, resp, err := client.Issues.CreateLabel(context.Background(), owner, repo, label)
+	if err != nil {
+		return nil, fmt.Errorf("CreateLabel: error creating label: %w", err)
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("CreateLabel: non successful response code: %s", resp.Status)
+	}
+	return created, nil
+}
+
+// EditLabel updates the label called name. Setting label.Name renames it.
+func (a *API) EditLabel(repo, name string, label *github.Label) (*github.Label, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("EditLabel: %w", err)
+	}
+	// go-github does not escape the label name, which breaks on names
+	// containing spaces or slashes.
+	edited, resp, err := client.Issues.EditLabel(context.Background(), owner, repo, url.PathEscape(name), label)
+	if err != nil {
+		return nil, fmt.Errorf("EditLabel: error editing label %q: %w", name, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("EditLabel: non successful response code: %s", resp.Status)
+	}
+	return edited, nil
+}
+
+func (a *API) DeleteLabel(repo, name string) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("DeleteLabel: %w", err)
+	}
+	resp, err := client.Issues.DeleteLabel(context.Background(), owner, repo, url.PathEscape(name))
+	if err != nil {
+		return fmt.Errorf("DeleteLabel: error deleting label %q: %w", name, err)
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("DeleteLabel: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
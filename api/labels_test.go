@@ -0,0 +1,80 @@
+package api_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/github"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_ListLabels(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		header := make(http.Header)
+		body := `[{"name": "bug", "color": "d73a4a"}]`
+		switch req.URL.String() {
+		case "https://api.github.com/repos/TheAlgorithms/Go/labels?per_page=100":
+			header.Set("Link", `<https://api.github.com/repos/TheAlgorithms/Go/labels?page=2&per_page=100>; rel="next"`)
+		case "https://api.github.com/repos/TheAlgorithms/Go/labels?page=2&per_page=100":
+			body = `[{"name": "good first issue", "color": "7057ff"}]`
+		default:
+			t.Errorf("ListLabels unexpected URL = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     header,
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListLabels("TheAlgorithms/Go")
+	if err != nil {
+		t.Fatalf("ListLabels() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("ListLabels() got %d labels, want 2", len(got))
+	}
+	if got[0].GetName() != "bug" || got[1].GetName() != "good first issue" {
+		t.Errorf("ListLabels() got names %q and %q", got[0].GetName(), got[1].GetName())
+	}
+}
+
+func Test_api_CreateLabel(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "POST" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/labels" {
+			t.Errorf("CreateLabel request = %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"name": "bug", "color": "d73a4a"}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.CreateLabel("TheAlgorithms/Go", &github.Label{Name: github.String("bug"), Color: github.String("d73a4a")})
+	if err != nil {
+		t.Fatalf("CreateLabel() error = %v", err)
+	}
+	if got.GetName() != "bug" {
+		t.Errorf("CreateLabel() got name %q, want %q", got.GetName(), "bug")
+	}
+}
+
+func Test_api_DeleteLabel(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "DELETE" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/labels/good%20first%20issue" {
+			t.Errorf("DeleteLabel request = %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 204,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	if err := app.DeleteLabel("TheAlgorithms/Go", "good first issue"); err != nil {
+		t.Errorf("DeleteLabel() error = %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/github"
+)
+
+func (a *API) ListMilestones(repo, state string) ([]*github.Milestone, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListMilestones: %w", err)
+	}
+	opt := github.MilestoneListOptions{
+		State: state,
+	}
+	var milestones []*github.Milestone
+	for {
+		page, resp, err := client.Issues.ListMilestones(context.Background(), owner, repo, &opt)
+		if err != nil {
+			return nil, fmt.Errorf("ListMilestones: error retrieving milestones: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListMilestones: non successful response code: %s", resp.Status)
+		}
+		milestones = append(milestones, page...)
+		if resp.NextPage == 0 {
+			return milestones, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// FindMilestone returns the open or closed milestone with the given title.
+// Titles are compared case insensitively.
+func (a *API) FindMilestone(repo, title string) (*github.Milestone, error) {
+	milestones, err := a.ListMilestones(repo, "all")
+	if err != nil {
+		return nil, fmt.Errorf("FindMilestone: %w", err)
+	}
+	for _, milestone := range milestones {
+		if strings.EqualFold(milestone.GetTitle(), title) {
+			return milestone, nil
+		}
+	}
+	return nil, fmt.Errorf("FindMilestone: no milestone called %q in %s", title, repo)
+}
+
+func (a *API) CreateMilestone(repo string, milestone *github.Milestone) (*github.Milestone, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("CreateMilestone: %w", err)
+	}
+	created, resp, err := client.Issues.CreateMilestone(context.Background(), owner, repo, milestone)
+	if err != nil {
+		return nil, fmt.Errorf("CreateMilestone: error creating milestone: %w", err)
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("CreateMilestone: non successful response code: %s", resp.Status)
+	}
+	return created, nil
+}
+
+func (a *API) EditMilestone(repo string, number int, milestone *github.Milestone) (*github.Milestone, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("EditMilestone: %w", err)
+	}
+	edited, resp, err := client.Issues.EditMilestone(context.Background(), owner, repo, number, milestone)
+	if err != nil {
+		return nil, fmt.Errorf("EditMilestone: error editing milestone: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("EditMilestone: non successful response code: %s", resp.Status)
+	}
+	return edited, nil
+}
+
+// ListMilestoneIssues lists the issues and PRs in the milestone with the given
+// number.
+func (a *API) ListMilestoneIssues(repo string, number int, state string) ([]*github.Issue, error) {
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListMilestoneIssues: %w", err)
+	}
+	opt := github.IssueListByRepoOptions{
+		Milestone: strconv.Itoa(number),
+		State:     state,
+	}
+	issues, err := a.listIssues(owner, repo, &opt)
+	if err != nil {
+		return nil, fmt.Errorf("ListMilestoneIssues: %w", err)
+	}
+	return issues, nil
+}
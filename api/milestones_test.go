@@ -0,0 +1,62 @@
+package api_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_FindMilestone(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/milestones?state=all" {
+			t.Errorf("FindMilestone URL = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`[{"number": 1, "title": "v1.0"}, {"number": 2, "title": "v2.0"}]`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.FindMilestone("TheAlgorithms/Go", "V2.0")
+	if err != nil {
+		t.Fatalf("FindMilestone() error = %v", err)
+	}
+	if got.GetNumber() != 2 {
+		t.Errorf("FindMilestone() got number %d, want 2", got.GetNumber())
+	}
+	if _, err := app.FindMilestone("TheAlgorithms/Go", "v3.0"); err == nil {
+		t.Errorf("FindMilestone() of a missing milestone didn't return an error")
+	}
+}
+
+func Test_api_ListMilestoneIssues(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		header := make(http.Header)
+		body := `[{"number": 1, "state": "open"}]`
+		switch req.URL.String() {
+		case "https://api.github.com/repos/TheAlgorithms/Go/issues?milestone=3&state=all":
+			header.Set("Link", `<https://api.github.com/repos/TheAlgorithms/Go/issues?milestone=3&page=2&state=all>; rel="next"`)
+		case "https://api.github.com/repos/TheAlgorithms/Go/issues?milestone=3&page=2&state=all":
+			body = `[{"number": 2, "state": "closed"}]`
+		default:
+			t.Errorf("ListMilestoneIssues unexpected URL = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     header,
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListMilestoneIssues("TheAlgorithms/Go", 3, "all")
+	if err != nil {
+		t.Fatalf("ListMilestoneIssues() error = %v", err)
+	}
+	if len(got) != 2 || got[1].GetNumber() != 2 {
+		t.Errorf("ListMilestoneIssues() got %v, want issues 1 and 2", got)
+	}
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+// NotificationOptions filters the notifications of the authenticated user.
+// Repo, when set, limits them to one repository.
+type NotificationOptions struct {
+	All           bool
+	Participating bool
+	Repo          string
+}
+
+// NotificationPoll is the result of PollNotifications. Notifications is nil
+// when nothing changed since the last poll.
+type NotificationPoll struct {
+	Notifications []*github.Notification
+	LastModified  string
+	Interval      time.Duration
+}
+
+func (a *API) ListNotifications(opts NotificationOptions) ([]*github.Notification, error) {
+	client := github.NewClient(a.client)
+	opt := github.NotificationListOptions{
+		All:           opts.All,
+		Participating: opts.Participating,
+		ListOptions:   github.ListOptions{PerPage: 50},
+	}
+	var owner, repo string
+	if opts.Repo != "" {
+		var err error
+		owner, repo, err = getOwnerAndRepo(opts.Repo)
+		if err != nil {
+			return nil, fmt.Errorf("ListNotifications: %w", err)
+		}
+	}
+	var notifications []*github.Notification
+	for {
+		var page []*github.Notification
+		var resp *github.Response
+		var err error
+		if opts.Repo != "" {
+			page, resp, err = client.Activity.ListRepositoryNotifications(context.Background(), owner, repo, &opt)
+		} else {
+			page, resp, err = client.Activity.ListNotifications(context.Background(), &opt)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ListNotifications: error retrieving notifications: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListNotifications: non successful response code: %s", resp.Status)
+		}
+		notifications = append(notifications, page...)
+		if resp.NextPage == 0 {
+			return notifications, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// PollNotifications fetches every page of the latest notifications unless none
+// changed since lastModified, the LastModified of the previous poll. Requests which
+// find nothing new don't count against the rate limit. Callers should wait
+// Interval, as asked by GitHub, before polling again.
+func (a *API) PollNotifications(opts NotificationOptions, lastModified string) (*NotificationPoll, error) {
+	client := github.NewClient(a.client)
+	path := "notifications"
+	if opts.Repo != "" {
+		owner, repo, err := getOwnerAndRepo(opts.Repo)
+		if err != nil {
+			return nil, fmt.Errorf("PollNotifications: %w", err)
+		}
+		path = fmt.Sprintf("repos/%s/%s/notifications", owner, repo)
+	}
+	query := url.Values{"per_page": {"50"}}
+	if opts.All {
+		query.Set("all", "true")
+	}
+	if opts.Participating {
+		query.Set("participating", "true")
+	}
+	get := func(modifiedSince string) ([]*github.Notification, *github.Response, error) {
+		req, err := client.NewRequest("GET", path+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if modifiedSince != "" {
+			req.Header.Set("If-Modified-Since", modifiedSince)
+		}
+		var notifications []*github.Notification
+		resp, err := client.Do(context.Background(), req, &notifications)
+		return notifications, resp, err
+	}
+	notifications, resp, err := get(lastModified)
+	if resp == nil || resp.StatusCode != 304 {
+		if err != nil {
+			return nil, fmt.Errorf("PollNotifications: error retrieving notifications: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("PollNotifications: non successful response code: %s", resp.Status)
+		}
+	}
+	poll := &NotificationPoll{
+		Notifications: notifications,
+		LastModified:  lastModified,
+		Interval:      time.Minute,
+	}
+	if modified := resp.Header.Get("Last-Modified"); modified != "" {
+		poll.LastModified = modified
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("X-Poll-Interval")); err == nil && seconds > 0 {
+		poll.Interval = time.Duration(seconds) * time.Second
+	}
+	// Only the first page is conditional, the rest are fetched when it changed.
+	for resp.NextPage != 0 {
+		query.Set("page", strconv.Itoa(resp.NextPage))
+		notifications, resp, err = get("")
+		if err != nil {
+			return nil, fmt.Errorf("PollNotifications: error retrieving notifications: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("PollNotifications: non successful response code: %s", resp.Status)
+		}
+		poll.Notifications = append(poll.Notifications, notifications...)
+	}
+	return poll, nil
+}
+
+// MarkNotificationsRead marks every notification, or only those of repo when
+// it isn't empty, as read.
+func (a *API) MarkNotificationsRead(repo string) error {
+	client := github.NewClient(a.client)
+	var resp *github.Response
+	var err error
+	if repo != "" {
+		var owner string
+		owner, repo, err = getOwnerAndRepo(repo)
+		if err != nil {
+			return fmt.Errorf("MarkNotificationsRead: %w", err)
+		}
+		resp, err = client.Activity.MarkRepositoryNotificationsRead(context.Background(), owner, repo, time.Now())
+	} else {
+		resp, err = client.Activity.MarkNotificationsRead(context.Background(), time.Now())
+	}
+	var accepted *github.AcceptedError
+	if errors.As(err, &accepted) {
+		// Too many notifications to mark synchronously, GitHub marks them
+		// in the background.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("MarkNotificationsRead: error marking notifications as read: %w", err)
+	}
+	if resp.StatusCode != 205 {
+		return fmt.Errorf("MarkNotificationsRead: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+func (a *API) MarkThreadRead(id string) error {
+	client := github.NewClient(a.client)
+	resp, err := client.Activity.MarkThreadRead(context.Background(), id)
+	if err != nil {
+		return fmt.Errorf("MarkThreadRead: error marking thread %s as read: %w", id, err)
+	}
+	if resp.StatusCode != 205 {
+		return fmt.Errorf("MarkThreadRead: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+// UnsubscribeThread mutes a thread until the user is mentioned or comments
+// on it again.
+func (a *API) UnsubscribeThread(id string) error {
+	client := github.NewClient(a.client)
+	subscription := &github.Subscription{Ignored: github.Bool(true)}
+	_, resp, err := client.Activity.SetThreadSubscription(context.Background(), id, subscription)
+	if err != nil {
+		return fmt.Errorf("UnsubscribeThread: error unsubscribing from thread %s: %w", id, err)
+	}
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("UnsubscribeThread: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
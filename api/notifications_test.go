@@ -0,0 +1,91 @@
+package api_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_PollNotifications(t *testing.T) {
+	const modified = "Mon, 19 Oct 2026 10:00:00 GMT"
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/repos/TheAlgorithms/Go/notifications" || req.URL.Query().Get("participating") != "true" {
+			t.Errorf("PollNotifications request = %v", req.URL)
+		}
+		header := make(http.Header)
+		header.Set("X-Poll-Interval", "90")
+		if req.Header.Get("If-Modified-Since") == modified {
+			return &http.Response{
+				StatusCode: 304,
+				Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+				Header:     header,
+				Request:    req,
+			}
+		}
+		if req.URL.Query().Get("page") == "2" {
+			if req.Header.Get("If-Modified-Since") != "" {
+				t.Errorf("PollNotifications asked for page 2 only if modified")
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`[{"id": "2", "reason": "author"}]`)),
+				Header:     make(http.Header),
+			}
+		}
+		header.Set("Last-Modified", modified)
+		header.Set("Link", `<https://api.github.com/repos/TheAlgorithms/Go/notifications?page=2>; rel="next"`)
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`[{"id": "1", "reason": "mention"}]`)),
+			Header:     header,
+		}
+	})
+	a := api.NewApi(cl)
+	opts := api.NotificationOptions{Participating: true, Repo: "TheAlgorithms/Go"}
+	poll, err := a.PollNotifications(opts, "")
+	if err != nil {
+		t.Fatalf("PollNotifications() error = %v", err)
+	}
+	if len(poll.Notifications) != 2 || poll.LastModified != modified || poll.Interval != 90*time.Second {
+		t.Errorf("PollNotifications() = %+v", poll)
+	}
+	poll, err = a.PollNotifications(opts, poll.LastModified)
+	if err != nil {
+		t.Fatalf("PollNotifications() of unmodified notifications error = %v", err)
+	}
+	if poll.Notifications != nil || poll.LastModified != modified {
+		t.Errorf("PollNotifications() of unmodified notifications = %+v", poll)
+	}
+}
+
+func Test_api_MarkNotificationsRead(t *testing.T) {
+	tests := []struct {
+		repo   string
+		path   string
+		status int
+	}{
+		{"", "/notifications", 205},
+		{"TheAlgorithms/Go", "/repos/TheAlgorithms/Go/notifications", 205},
+		{"", "/notifications", 202},
+	}
+	for _, tt := range tests {
+		cl := newTestClient(func(req *http.Request) *http.Response {
+			if req.Method != "PUT" || req.URL.Path != tt.path {
+				t.Errorf("MarkNotificationsRead request = %v %v, want %v", req.Method, req.URL.Path, tt.path)
+			}
+			return &http.Response{
+				StatusCode: tt.status,
+				Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+				Header:     make(http.Header),
+			}
+		})
+		a := api.NewApi(cl)
+		if err := a.MarkNotificationsRead(tt.repo); err != nil {
+			t.Errorf("MarkNotificationsRead(%q) on %d error = %v", tt.repo, tt.status, err)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-github/github"
+)
+
+// GetBranchProtection returns the protection rules of a branch, or nil when
+// the branch isn't protected. Reading them needs admin access to repo.
+func (a *API) GetBranchProtection(repo, branch string) (*github.Protection, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("GetBranchProtection: %w", err)
+	}
+	protection, resp, err := client.Repositories.GetBranchProtection(context.Background(), owner, repo, url.PathEscape(branch))
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 404 && errResp.Message == "Branch not protected" {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("GetBranchProtection: error retrieving protection of %s: %w", branch, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("GetBranchProtection: non successful response code: %s", resp.Status)
+	}
+	return protection, nil
+}
+
+// UpdateBranchProtection replaces the protection rules of a branch, which
+// protects it if it wasn't. It needs admin access to repo.
+func (a *API) UpdateBranchProtection(repo, branch string, protection *github.ProtectionRequest) (*github.Protection, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateBranchProtection: %w", err)
+	}
+	updated, resp, err := client.Repositories.UpdateBranchProtection(context.Background(), owner, repo, url.PathEscape(branch), protection)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 422 {
+		return nil, fmt.Errorf("UpdateBranchProtection: can't protect %s: %s", branch, validationMessage(errResp))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("UpdateBranchProtection: error updating protection of %s: %w", branch, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("UpdateBranchProtection: non successful response code: %s", resp.Status)
+	}
+	return updated, nil
+}
+
+type enabledSetting struct {
+	Enabled bool `json:"enabled"`
+}
+
+type actorList struct {
+	Users []json.RawMessage `json:"users"`
+	Teams []json.RawMessage `json:"teams"`
+	Apps  []json.RawMessage `json:"apps"`
+}
+
+func (l *actorList) set() bool {
+	return l != nil && len(l.Users)+len(l.Teams)+len(l.Apps) > 0
+}
+
+// UnsupportedProtection returns the protection rules of a branch which are on
+// but which github.Protection leaves out, such as required linear history.
+// UpdateBranchProtection turns them off, as it replaces every rule.
+func (a *API) UnsupportedProtection(repo, branch string) ([]string, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("UnsupportedProtection: %w", err)
+	}
+	req, err := client.NewRequest("GET", fmt.Sprintf("repos/%s/%s/branches/%s/protection", owner, repo, url.PathEscape(branch)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("UnsupportedProtection: %w", err)
+	}
+	var protection struct {
+		RequiredLinearHistory          *enabledSetting `json:"required_linear_history"`
+		AllowForcePushes               *enabledSetting `json:"allow_force_pushes"`
+		AllowDeletions                 *enabledSetting `json:"allow_deletions"`
+		RequiredConversationResolution *enabledSetting `json:"required_conversation_resolution"`
+		BlockCreations                 *enabledSetting `json:"block_creations"`
+		LockBranch                     *enabledSetting `json:"lock_branch"`
+		AllowForkSyncing               *enabledSetting `json:"allow_fork_syncing"`
+		RequiredStatusChecks           *struct {
+			Checks []struct {
+				AppID *int64 `json:"app_id"`
+			} `json:"checks"`
+		} `json:"required_status_checks"`
+		RequiredPullRequestReviews *struct {
+			DismissalRestrictions       *actorList `json:"dismissal_restrictions"`
+			BypassPullRequestAllowances *actorList `json:"bypass_pull_request_allowances"`
+			RequireLastPushApproval     bool       `json:"require_last_push_approval"`
+		} `json:"required_pull_request_reviews"`
+		Restrictions *actorList `json:"restrictions"`
+	}
+	resp, err := client.Do(context.Background(), req, &protection)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 404 && errResp.Message == "Branch not protected" {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("UnsupportedProtection: error retrieving protection of %s: %w", branch, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("UnsupportedProtection: non successful response code: %s", resp.Status)
+	}
+	var rules []string
+	for _, setting := range []struct {
+		name    string
+		setting *enabledSetting
+	}{
+		{"required_linear_history", protection.RequiredLinearHistory},
+		{"allow_force_pushes", protection.AllowForcePushes},
+		{"allow_deletions", protection.AllowDeletions},
+		{"required_conversation_resolution", protection.RequiredConversationResolution},
+		{"block_creations", protection.BlockCreations},
+		{"lock_branch", protection.LockBranch},
+		{"allow_fork_syncing", protection.AllowForkSyncing},
+	} {
+		if setting.setting != nil && setting.setting.Enabled {
+			rules = append(rules, setting.name)
+		}
+	}
+	if c := protection.RequiredStatusChecks; c != nil {
+		for _, check := range c.Checks {
+			if check.AppID != nil && *check.AppID > 0 {
+				rules = append(rules, "required_status_checks.checks pinned to an app")
+				break
+			}
+		}
+	}
+	if r := protection.RequiredPullRequestReviews; r != nil {
+		// GitHub only returns dismissal_restrictions when they are on.
+		if r.DismissalRestrictions != nil {
+			rules = append(rules, "required_pull_request_reviews.dismissal_restrictions")
+		}
+		if r.BypassPullRequestAllowances.set() {
+			rules = append(rules, "required_pull_request_reviews.bypass_pull_request_allowances")
+		}
+		if r.RequireLastPushApproval {
+			rules = append(rules, "required_pull_request_reviews.require_last_push_approval")
+		}
+	}
+	if r := protection.Restrictions; r != nil && len(r.Apps) > 0 {
+		rules = append(rules, "restrictions.apps")
+	}
+	return rules, nil
+}
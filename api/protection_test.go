@@ -0,0 +1,95 @@
+package api_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-github/github"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_GetBranchProtection(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		switch req.URL.Path {
+		case "/repos/TheAlgorithms/Go/branches/main/protection":
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"required_pull_request_reviews": {"required_approving_review_count": 2}}`)),
+				Header:     make(http.Header),
+			}
+		case "/repos/TheAlgorithms/Go/branches/dev/protection":
+			return &http.Response{
+				StatusCode: 404,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"message": "Branch not protected"}`)),
+				Header:     make(http.Header),
+				Request:    req,
+			}
+		}
+		t.Errorf("unexpected request %v", req.URL)
+		return nil
+	})
+	app := api.NewApi(cl)
+	got, err := app.GetBranchProtection("TheAlgorithms/Go", "main")
+	if err != nil {
+		t.Fatalf("GetBranchProtection() error = %v", err)
+	}
+	if got.GetRequiredPullRequestReviews().RequiredApprovingReviewCount != 2 {
+		t.Errorf("GetBranchProtection() = %v", got)
+	}
+	got, err = app.GetBranchProtection("TheAlgorithms/Go", "dev")
+	if err != nil || got != nil {
+		t.Errorf("GetBranchProtection() of an unprotected branch = %v, %v", got, err)
+	}
+}
+
+func Test_api_UpdateBranchProtection(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "PUT" || req.URL.Path != "/repos/TheAlgorithms/Go/branches/release/v1/protection" {
+			t.Errorf("UpdateBranchProtection request = %v %v", req.Method, req.URL.Path)
+		}
+		return &http.Response{
+			StatusCode: 422,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"message": "Validation Failed", "errors": [{"code": "custom", "message": "Only organization repositories can have users and team restrictions"}]}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})
+	app := api.NewApi(cl)
+	_, err := app.UpdateBranchProtection("TheAlgorithms/Go", "release/v1", &github.ProtectionRequest{})
+	want := "UpdateBranchProtection: can't protect release/v1: Only organization repositories can have users and team restrictions"
+	if err == nil || err.Error() != want {
+		t.Errorf("UpdateBranchProtection() error = %v, want %v", err, want)
+	}
+}
+
+func Test_api_UnsupportedProtection(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: 200,
+			Body: ioutil.NopCloser(bytes.NewBufferString(`{
+				"required_status_checks": {"contexts": ["build"], "checks": [{"context": "build", "app_id": 15368}]},
+				"required_pull_request_reviews": {"dismissal_restrictions": {"users": [], "teams": []},
+					"bypass_pull_request_allowances": {"users": [], "teams": [], "apps": []}},
+				"allow_deletions": {"enabled": false},
+				"required_conversation_resolution": {"enabled": true},
+				"restrictions": {"users": [{"login": "octocat"}], "teams": [], "apps": []}}`)),
+			Header: make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.UnsupportedProtection("TheAlgorithms/Go", "main")
+	if err != nil {
+		t.Fatalf("UnsupportedProtection() error = %v", err)
+	}
+	want := []string{
+		"required_conversation_resolution",
+		"required_status_checks.checks pinned to an app",
+		"required_pull_request_reviews.dismissal_restrictions",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UnsupportedProtection() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,415 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-github/github"
+)
+
+// NewPullRequest describes a PR to open. It mirrors github.NewPullRequest,
+// which predates draft PRs.
+type NewPullRequest struct {
+	Title               string `json:"title"`
+	Head                string `json:"head"`
+	Base                string `json:"base"`
+	Body                string `json:"body,omitempty"`
+	Draft               bool   `json:"draft,omitempty"`
+	MaintainerCanModify *bool  `json:"maintainer_can_modify,omitempty"`
+}
+
+func (a *API) CreatePR(repo string, pr *NewPullRequest) (*github.PullRequest, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("CreatePR: %w", err)
+	}
+	req, err := client.NewRequest("POST", fmt.Sprintf("repos/%s/%s/pulls", owner, repo), pr)
+	if err != nil {
+		return nil, fmt.Errorf("CreatePR: %w", err)
+	}
+	created := new(github.PullRequest)
+	resp, err := client.Do(context.Background(), req, created)
+	if err != nil {
+		return nil, fmt.Errorf("CreatePR: error creating PR: %w", err)
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("CreatePR: non successful response code: %s", resp.Status)
+	}
+	return created, nil
+}
+
+// FindPRForHead returns the open PR whose head is the branch head, given as
+// "owner:branch" or just "branch" for branches of repo itself. It returns nil
+// when there is no such PR.
+func (a *API) FindPRForHead(repo, head string) (*github.PullRequest, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("FindPRForHead: %w", err)
+	}
+	if !strings.Contains(head, ":") {
+		head = owner + ":" + head
+	}
+	opt := github.PullRequestListOptions{
+		State: "open",
+		Head:  head,
+	}
+	prs, resp, err := client.PullRequests.List(context.Background(), owner, repo, &opt)
+	if err != nil {
+		return nil, fmt.Errorf("FindPRForHead: error retrieving PRs: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("FindPRForHead: non successful response code: %s", resp.Status)
+	}
+	if len(prs) == 0 {
+		return nil, nil
+	}
+	return prs[0], nil
+}
+
+// ListHeadPRs returns the open, closed and merged PRs whose head is the branch
+// head, given as for FindPRForHead.
+func (a *API) ListHeadPRs(repo, head string) ([]*github.PullRequest, error) {
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListHeadPRs: %w", err)
+	}
+	if !strings.Contains(head, ":") {
+		head = owner + ":" + head
+	}
+	query := url.Values{"state": {"all"}, "head": {head}}
+	prs, err := a.listPRs(fmt.Sprintf("repos/%s/%s/pulls", owner, repo), query, "")
+	if err != nil {
+		return nil, fmt.Errorf("ListHeadPRs: %w", err)
+	}
+	return prs, nil
+}
+
+// RequestReviewers requests reviews from users and teams. Teams are given as
+// "org/team-slug".
+func (a *API) RequestReviewers(repo string, number int, reviewers []string) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("RequestReviewers: %w", err)
+	}
+	_, resp, err := client.PullRequests.RequestReviewers(context.Background(), owner, repo, number, splitReviewers(reviewers))
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 422 {
+		if strings.Contains(errResp.Message, "collaborator") {
+			return fmt.Errorf("RequestReviewers: can't request reviews of #%d from %s, only collaborators of %s/%s can review", number, strings.Join(reviewers, ", "), owner, repo)
+		}
+		return fmt.Errorf("RequestReviewers: can't request reviews of #%d from %s: %s", number, strings.Join(reviewers, ", "), validationMessage(errResp))
+	}
+	if err != nil {
+		return fmt.Errorf("RequestReviewers: error requesting reviewers for #%d: %w", number, err)
+	}
+	if resp.StatusCode != 201 {
+		return fmt.Errorf("RequestReviewers: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+// RemoveReviewers withdraws review requests from users and teams. Teams are
+// given as "org/team-slug".
+func (a *API) RemoveReviewers(repo string, number int, reviewers []string) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("RemoveReviewers: %w", err)
+	}
+	resp, err := client.PullRequests.RemoveReviewers(context.Background(), owner, repo, number, splitReviewers(reviewers))
+	if err != nil {
+		return fmt.Errorf("RemoveReviewers: error removing reviewers from #%d: %w", number, err)
+	}
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("RemoveReviewers: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+// validationMessage returns the reasons GitHub gives for rejecting a request
+// as invalid, which are more helpful than the "Validation Failed" message.
+func validationMessage(errResp *github.ErrorResponse) string {
+	var reasons []string
+	for _, e := range errResp.Errors {
+		if e.Message != "" {
+			reasons = append(reasons, e.Message)
+		}
+	}
+	if len(reasons) == 0 {
+		return errResp.Message
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// EditPR updates the title, body, state or base branch of a PR, whichever are
+// set in pr. The base branch is set with pr.Base.Ref.
+func (a *API) EditPR(repo string, number int, pr *github.PullRequest) (*github.PullRequest, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("EditPR: %w", err)
+	}
+	edited, resp, err := client.PullRequests.Edit(context.Background(), owner, repo, number, pr)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 422 {
+		return nil, fmt.Errorf("EditPR: can't edit #%d: %s", number, validationMessage(errResp))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("EditPR: error editing #%d: %w", number, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("EditPR: non successful response code: %s", resp.Status)
+	}
+	return edited, nil
+}
+
+// MarkPRReady marks a draft PR as ready for review. Only the GraphQL API
+// supports it, so the PR is identified by its node ID.
+func (a *API) MarkPRReady(nodeID string) error {
+	query := `mutation($input: MarkPullRequestReadyForReviewInput!) {
+	markPullRequestReadyForReview(input: $input) { clientMutationId }
+}`
+	input := map[string]interface{}{"pullRequestId": nodeID}
+	if err := a.graphQL(query, map[string]interface{}{"input": input}, nil); err != nil {
+		return fmt.Errorf("MarkPRReady: %w", err)
+	}
+	return nil
+}
+
+// ConvertPRToDraft turns a PR back into a draft. Only the GraphQL API supports
+// it, so the PR is identified by its node ID.
+func (a *API) ConvertPRToDraft(nodeID string) error {
+	query := `mutation($input: ConvertPullRequestToDraftInput!) {
+	convertPullRequestToDraft(input: $input) { clientMutationId }
+}`
+	input := map[string]interface{}{"pullRequestId": nodeID}
+	if err := a.graphQL(query, map[string]interface{}{"input": input}, nil); err != nil {
+		return fmt.Errorf("ConvertPRToDraft: %w", err)
+	}
+	return nil
+}
+
+// splitReviewers separates teams, given as "org/team-slug", from users.
+func splitReviewers(reviewers []string) github.ReviewersRequest {
+	var req github.ReviewersRequest
+	for _, reviewer := range reviewers {
+		if i := strings.Index(reviewer, "/"); i >= 0 {
+			req.TeamReviewers = append(req.TeamReviewers, reviewer[i+1:])
+			continue
+		}
+		req.Reviewers = append(req.Reviewers, reviewer)
+	}
+	return req
+}
+
+// GetPRDiff returns the changes of a PR as a unified diff, or as a series of
+// patches in the git format-patch format when patch is set.
+func (a *API) GetPRDiff(repo string, number int, patch bool) (string, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return "", fmt.Errorf("GetPRDiff: %w", err)
+	}
+	opt := github.RawOptions{Type: github.Diff}
+	if patch {
+		opt.Type = github.Patch
+	}
+	diff, resp, err := client.PullRequests.GetRaw(context.Background(), owner, repo, number, opt)
+	if err != nil {
+		return "", fmt.Errorf("GetPRDiff: error retrieving diff of #%d: %w", number, err)
+	}
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("GetPRDiff: non successful response code: %s", resp.Status)
+	}
+	return diff, nil
+}
+
+// ListPRFiles returns the files changed by a PR. GitHub stops listing files
+// after the first 3000, so compare the result with the ChangedFiles of the PR
+// to know whether it is complete.
+func (a *API) ListPRFiles(repo string, number int) ([]*github.CommitFile, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListPRFiles: %w", err)
+	}
+	opt := github.ListOptions{PerPage: 100}
+	var files []*github.CommitFile
+	for {
+		page, resp, err := client.PullRequests.ListFiles(context.Background(), owner, repo, number, &opt)
+		if err != nil {
+			return nil, fmt.Errorf("ListPRFiles: error retrieving files of #%d: %w", number, err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListPRFiles: non successful response code: %s", resp.Status)
+		}
+		files = append(files, page...)
+		if resp.NextPage == 0 {
+			return files, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// MergeOptions controls how MergePR merges a PR. Empty fields use the
+// defaults of GitHub.
+type MergeOptions struct {
+	// Method is one of "merge", "squash" or "rebase".
+	Method  string `json:"merge_method,omitempty"`
+	Subject string `json:"commit_title,omitempty"`
+	Body    string `json:"commit_message,omitempty"`
+	// SHA makes the merge fail unless it is the head of the PR.
+	SHA string `json:"sha,omitempty"`
+}
+
+func (a *API) MergePR(repo string, number int, opt *MergeOptions) (*github.PullRequestMergeResult, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("MergePR: %w", err)
+	}
+	req, err := client.NewRequest("PUT", fmt.Sprintf("repos/%s/%s/pulls/%d/merge", owner, repo, number), opt)
+	if err != nil {
+		return nil, fmt.Errorf("MergePR: %w", err)
+	}
+	result := new(github.PullRequestMergeResult)
+	resp, err := client.Do(context.Background(), req, result)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) {
+		switch errResp.Response.StatusCode {
+		case 405:
+			return nil, fmt.Errorf("MergePR: #%d can't be merged: %s", number, errResp.Message)
+		case 409:
+			return nil, fmt.Errorf("MergePR: the head of #%d doesn't match %s", number, opt.SHA)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("MergePR: error merging #%d: %w", number, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("MergePR: non successful response code: %s", resp.Status)
+	}
+	return result, nil
+}
+
+// EnableAutoMerge makes GitHub merge a PR as soon as its requirements are met.
+// Only the GraphQL API supports it, so the PR is identified by its node ID.
+func (a *API) EnableAutoMerge(nodeID string, opt *MergeOptions) error {
+	input := map[string]interface{}{
+		"pullRequestId": nodeID,
+	}
+	if opt.Method != "" {
+		input["mergeMethod"] = strings.ToUpper(opt.Method)
+	}
+	if opt.Subject != "" {
+		input["commitHeadline"] = opt.Subject
+	}
+	if opt.Body != "" {
+		input["commitBody"] = opt.Body
+	}
+	if opt.SHA != "" {
+		input["expectedHeadOid"] = opt.SHA
+	}
+	query := `mutation($input: EnablePullRequestAutoMergeInput!) {
+	enablePullRequestAutoMerge(input: $input) { clientMutationId }
+}`
+	if err := a.graphQL(query, map[string]interface{}{"input": input}, nil); err != nil {
+		return fmt.Errorf("EnableAutoMerge: %w", err)
+	}
+	return nil
+}
+
+// UpdatePRBranch merges the base branch of a PR into its head branch. GitHub
+// refuses when the head of the PR isn't expectedSHA, so commits pushed in the
+// meantime aren't lost.
+func (a *API) UpdatePRBranch(repo string, number int, expectedSHA string) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("UpdatePRBranch: %w", err)
+	}
+	body := map[string]string{"expected_head_sha": expectedSHA}
+	req, err := client.NewRequest("PUT", fmt.Sprintf("repos/%s/%s/pulls/%d/update-branch", owner, repo, number), body)
+	if err != nil {
+		return fmt.Errorf("UpdatePRBranch: %w", err)
+	}
+	resp, err := client.Do(context.Background(), req, nil)
+	// GitHub updates the branch in the background.
+	var accepted *github.AcceptedError
+	if errors.As(err, &accepted) {
+		return nil
+	}
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 422 {
+		return fmt.Errorf("UpdatePRBranch: can't update #%d: %s", number, validationMessage(errResp))
+	}
+	if err != nil {
+		return fmt.Errorf("UpdatePRBranch: error updating #%d: %w", number, err)
+	}
+	return fmt.Errorf("UpdatePRBranch: non successful response code: %s", resp.Status)
+}
+
+// RebasePRBranch rebases the head branch of a PR onto its base branch, unless
+// the head isn't expectedSHA. Only the GraphQL API supports rebasing, so the
+// PR is identified by its node ID.
+func (a *API) RebasePRBranch(nodeID, expectedSHA string) error {
+	query := `mutation($input: UpdatePullRequestBranchInput!) {
+	updatePullRequestBranch(input: $input) { clientMutationId }
+}`
+	input := map[string]interface{}{
+		"pullRequestId":   nodeID,
+		"expectedHeadOid": expectedSHA,
+		"updateMethod":    "REBASE",
+	}
+	if err := a.graphQL(query, map[string]interface{}{"input": input}, nil); err != nil {
+		return fmt.Errorf("RebasePRBranch: %w", err)
+	}
+	return nil
+}
+
+// ListPRCommits returns the commits of a PR, oldest first. GitHub stops
+// listing commits after the first 250.
+func (a *API) ListPRCommits(repo string, number int) ([]*github.RepositoryCommit, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListPRCommits: %w", err)
+	}
+	opt := github.ListOptions{PerPage: 100}
+	var commits []*github.RepositoryCommit
+	for {
+		page, resp, err := client.PullRequests.ListCommits(context.Background(), owner, repo, number, &opt)
+		if err != nil {
+			return nil, fmt.Errorf("ListPRCommits: error retrieving commits of #%d: %w", number, err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListPRCommits: non successful response code: %s", resp.Status)
+		}
+		commits = append(commits, page...)
+		if resp.NextPage == 0 {
+			return commits, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// ListCommitPRs returns the PRs associated with the commit sha, that is the
+// open PRs containing it and the merged PR which introduced it.
+func (a *API) ListCommitPRs(repo, sha string) ([]*github.PullRequest, error) {
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListCommitPRs: %w", err)
+	}
+	query := url.Values{"per_page": {"100"}}
+	prs, err := a.listPRs(fmt.Sprintf("repos/%s/%s/commits/%s/pulls", owner, repo, sha), query, "application/vnd.github.groot-preview+json")
+	if err != nil {
+		return nil, fmt.Errorf("ListCommitPRs: %w", err)
+	}
+	return prs, nil
+}
@@ -0,0 +1,421 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/github"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_CreatePR(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "POST" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/pulls" {
+			t.Errorf("CreatePR request = %v %v", req.Method, req.URL)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		want := `{"title":"Add heap sort","head":"heap-sort","base":"master","draft":true}` + "\n"
+		if string(body) != want {
+			t.Errorf("CreatePR body = %q, want %q", body, want)
+		}
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"number": 5, "draft": true}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.CreatePR("TheAlgorithms/Go", &api.NewPullRequest{
+		Title: "Add heap sort",
+		Head:  "heap-sort",
+		Base:  "master",
+		Draft: true,
+	})
+	if err != nil {
+		t.Fatalf("CreatePR() error = %v", err)
+	}
+	if got.GetNumber() != 5 {
+		t.Errorf("CreatePR() got number %d, want 5", got.GetNumber())
+	}
+}
+
+func Test_api_FindPRForHead(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/pulls?head=TheAlgorithms%3Aheap-sort&state=open" {
+			t.Errorf("FindPRForHead URL = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`[]`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.FindPRForHead("TheAlgorithms/Go", "heap-sort")
+	if err != nil || got != nil {
+		t.Errorf("FindPRForHead() = %v, %v, want nil, nil", got, err)
+	}
+}
+
+func Test_api_RequestReviewers(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "POST" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/pulls/5/requested_reviewers" {
+			t.Errorf("RequestReviewers request = %v %v", req.Method, req.URL)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		want := `{"reviewers":["octocat"],"team_reviewers":["maintainers"]}` + "\n"
+		if string(body) != want {
+			t.Errorf("RequestReviewers body = %q, want %q", body, want)
+		}
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"number": 5}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	if err := app.RequestReviewers("TheAlgorithms/Go", 5, []string{"octocat", "TheAlgorithms/maintainers"}); err != nil {
+		t.Errorf("RequestReviewers() error = %v", err)
+	}
+}
+
+func Test_api_RequestReviewers_notCollaborator(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: 422,
+			Body: ioutil.NopCloser(bytes.NewBufferString(`{"message": "Reviews may only be requested from collaborators. ` +
+				`One or more of the users or teams you specified is not a collaborator of the TheAlgorithms/Go repository."}`)),
+			Header:  make(http.Header),
+			Request: req,
+		}
+	})
+	app := api.NewApi(cl)
+	err := app.RequestReviewers("TheAlgorithms/Go", 5, []string{"octocat"})
+	want := "RequestReviewers: can't request reviews of #5 from octocat, only collaborators of TheAlgorithms/Go can review"
+	if err == nil || err.Error() != want {
+		t.Errorf("RequestReviewers() error = %v, want %v", err, want)
+	}
+}
+
+func Test_api_RemoveReviewers(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "DELETE" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/pulls/5/requested_reviewers" {
+			t.Errorf("RemoveReviewers request = %v %v", req.Method, req.URL)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		want := `{"reviewers":["octocat"]}` + "\n"
+		if string(body) != want {
+			t.Errorf("RemoveReviewers body = %q, want %q", body, want)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"number": 5}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	if err := app.RemoveReviewers("TheAlgorithms/Go", 5, []string{"octocat"}); err != nil {
+		t.Errorf("RemoveReviewers() error = %v", err)
+	}
+}
+
+func Test_api_EditPR(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "PATCH" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/pulls/5" {
+			t.Errorf("EditPR request = %v %v", req.Method, req.URL)
+		}
+		var edit map[string]string
+		if err := json.NewDecoder(req.Body).Decode(&edit); err != nil {
+			t.Fatal(err)
+		}
+		if edit["base"] == "main" {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"number": 5, "base": {"ref": "main"}}`)),
+				Header:     make(http.Header),
+			}
+		}
+		return &http.Response{
+			StatusCode: 422,
+			Body: ioutil.NopCloser(bytes.NewBufferString(`{"message": "Validation Failed",
+				"errors": [{"resource": "PullRequest", "code": "custom", "message": "Proposed base branch 'nope' was not found"}]}`)),
+			Header:  make(http.Header),
+			Request: req,
+		}
+	})
+	app := api.NewApi(cl)
+	main, nope := "main", "nope"
+	got, err := app.EditPR("TheAlgorithms/Go", 5, &github.PullRequest{Base: &github.PullRequestBranch{Ref: &main}})
+	if err != nil {
+		t.Fatalf("EditPR() error = %v", err)
+	}
+	if got.GetBase().GetRef() != "main" {
+		t.Errorf("EditPR() base = %q, want main", got.GetBase().GetRef())
+	}
+	_, err = app.EditPR("TheAlgorithms/Go", 5, &github.PullRequest{Base: &github.PullRequestBranch{Ref: &nope}})
+	want := "EditPR: can't edit #5: Proposed base branch 'nope' was not found"
+	if err == nil || err.Error() != want {
+		t.Errorf("EditPR() error = %v, want %v", err, want)
+	}
+}
+
+func Test_api_MarkPRReady(t *testing.T) {
+	var queries []string
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		var body struct {
+			Query     string `json:"query"`
+			Variables struct {
+				Input map[string]string `json:"input"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body.Variables.Input["pullRequestId"] != "PR_1" {
+			t.Errorf("input = %v", body.Variables.Input)
+		}
+		queries = append(queries, body.Query)
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"data": {}}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	if err := app.MarkPRReady("PR_1"); err != nil {
+		t.Errorf("MarkPRReady() error = %v", err)
+	}
+	if err := app.ConvertPRToDraft("PR_1"); err != nil {
+		t.Errorf("ConvertPRToDraft() error = %v", err)
+	}
+	if len(queries) != 2 || !strings.Contains(queries[0], "markPullRequestReadyForReview") || !strings.Contains(queries[1], "convertPullRequestToDraft") {
+		t.Errorf("queries = %v", queries)
+	}
+}
+
+func Test_api_GetPRDiff(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/pulls/5" {
+			t.Errorf("GetPRDiff URL = %v", req.URL)
+		}
+		if got := req.Header.Get("Accept"); got != "application/vnd.github.v3.diff" {
+			t.Errorf("GetPRDiff Accept = %q", got)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("diff --git a/a b/a\n")),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.GetPRDiff("TheAlgorithms/Go", 5, false)
+	if err != nil {
+		t.Fatalf("GetPRDiff() error = %v", err)
+	}
+	if got != "diff --git a/a b/a\n" {
+		t.Errorf("GetPRDiff() = %q", got)
+	}
+}
+
+func Test_api_ListPRFiles(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		header := make(http.Header)
+		body := `[{"filename": "a.go"}]`
+		switch req.URL.String() {
+		case "https://api.github.com/repos/TheAlgorithms/Go/pulls/5/files?per_page=100":
+			header.Set("Link", `<https://api.github.com/repos/TheAlgorithms/Go/pulls/5/files?page=2&per_page=100>; rel="next"`)
+		case "https://api.github.com/repos/TheAlgorithms/Go/pulls/5/files?page=2&per_page=100":
+			body = `[{"filename": "b.go"}]`
+		default:
+			t.Errorf("ListPRFiles unexpected URL = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     header,
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListPRFiles("TheAlgorithms/Go", 5)
+	if err != nil {
+		t.Fatalf("ListPRFiles() error = %v", err)
+	}
+	if len(got) != 2 || got[1].GetFilename() != "b.go" {
+		t.Errorf("ListPRFiles() = %v", got)
+	}
+}
+
+func Test_api_MergePR(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "PUT" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/pulls/5/merge" {
+			t.Errorf("MergePR request = %v %v", req.Method, req.URL)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) == `{"merge_method":"squash","sha":"abc"}`+"\n" {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"sha": "def", "merged": true}`)),
+				Header:     make(http.Header),
+			}
+		}
+		return &http.Response{
+			StatusCode: 405,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"message": "Pull Request is not mergeable"}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.MergePR("TheAlgorithms/Go", 5, &api.MergeOptions{Method: "squash", SHA: "abc"})
+	if err != nil {
+		t.Fatalf("MergePR() error = %v", err)
+	}
+	if got.GetSHA() != "def" {
+		t.Errorf("MergePR() sha = %q, want def", got.GetSHA())
+	}
+	_, err = app.MergePR("TheAlgorithms/Go", 5, &api.MergeOptions{Method: "rebase"})
+	if err == nil || err.Error() != "MergePR: #5 can't be merged: Pull Request is not mergeable" {
+		t.Errorf("MergePR() error = %v", err)
+	}
+}
+
+func Test_api_EnableAutoMerge(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "POST" || req.URL.String() != "https://api.github.com/graphql" {
+			t.Errorf("EnableAutoMerge request = %v %v", req.Method, req.URL)
+		}
+		var body struct {
+			Variables struct {
+				Input map[string]string `json:"input"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]string{"pullRequestId": "PR_1", "mergeMethod": "SQUASH"}
+		if !reflect.DeepEqual(body.Variables.Input, want) {
+			t.Errorf("EnableAutoMerge input = %v, want %v", body.Variables.Input, want)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"errors": [{"message": "Auto merge is not allowed for this repository"}]}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	err := app.EnableAutoMerge("PR_1", &api.MergeOptions{Method: "squash"})
+	if err == nil || err.Error() != "EnableAutoMerge: Auto merge is not allowed for this repository" {
+		t.Errorf("EnableAutoMerge() error = %v", err)
+	}
+}
+
+func Test_api_UpdatePRBranch(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "PUT" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/pulls/5/update-branch" {
+			t.Errorf("UpdatePRBranch request = %v %v", req.Method, req.URL)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) == `{"expected_head_sha":"abc"}`+"\n" {
+			return &http.Response{
+				StatusCode: 202,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"message": "Updating pull request branch."}`)),
+				Header:     make(http.Header),
+			}
+		}
+		return &http.Response{
+			StatusCode: 422,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"message": "expected head sha didn't match current head ref."}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})
+	app := api.NewApi(cl)
+	if err := app.UpdatePRBranch("TheAlgorithms/Go", 5, "abc"); err != nil {
+		t.Errorf("UpdatePRBranch() error = %v", err)
+	}
+	err := app.UpdatePRBranch("TheAlgorithms/Go", 5, "old")
+	want := "UpdatePRBranch: can't update #5: expected head sha didn't match current head ref."
+	if err == nil || err.Error() != want {
+		t.Errorf("UpdatePRBranch() error = %v, want %v", err, want)
+	}
+}
+
+func Test_api_RebasePRBranch(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		var body struct {
+			Variables struct {
+				Input map[string]string `json:"input"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]string{"pullRequestId": "PR_1", "expectedHeadOid": "abc", "updateMethod": "REBASE"}
+		if !reflect.DeepEqual(body.Variables.Input, want) {
+			t.Errorf("RebasePRBranch input = %v, want %v", body.Variables.Input, want)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"data": {}}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	if err := app.RebasePRBranch("PR_1", "abc"); err != nil {
+		t.Errorf("RebasePRBranch() error = %v", err)
+	}
+}
+
+func Test_api_ListPRCommits(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		header := make(http.Header)
+		body := `[{"sha": "a"}]`
+		switch req.URL.String() {
+		case "https://api.github.com/repos/TheAlgorithms/Go/pulls/5/commits?per_page=100":
+			header.Set("Link", `<https://api.github.com/repos/TheAlgorithms/Go/pulls/5/commits?page=2&per_page=100>; rel="next"`)
+		case "https://api.github.com/repos/TheAlgorithms/Go/pulls/5/commits?page=2&per_page=100":
+			body = `[{"sha": "b"}]`
+		default:
+			t.Errorf("unexpected request %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     header,
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListPRCommits("TheAlgorithms/Go", 5)
+	if err != nil {
+		t.Fatalf("ListPRCommits() error = %v", err)
+	}
+	if len(got) != 2 || got[1].GetSHA() != "b" {
+		t.Errorf("ListPRCommits() = %v", got)
+	}
+}
+
+func Test_api_ListCommitPRs(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/commits/abc/pulls?per_page=100" {
+			t.Errorf("ListCommitPRs request = %v", req.URL)
+		}
+		if accept := req.Header.Get("Accept"); accept != "application/vnd.github.groot-preview+json" {
+			t.Errorf("ListCommitPRs Accept = %q", accept)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`[{"number": 3}]`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListCommitPRs("TheAlgorithms/Go", "abc")
+	if err != nil || len(got) != 1 || got[0].GetNumber() != 3 {
+		t.Errorf("ListCommitPRs() = %v, %v", got, err)
+	}
+}
@@ -0,0 +1,215 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-github/github"
+)
+
+func (a *API) ListReleases(repo string) ([]*github.RepositoryRelease, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListReleases: %w", err)
+	}
+	opt := github.ListOptions{PerPage: 100}
+	var releases []*github.RepositoryRelease
+	for {
+		page, resp, err := client.Repositories.ListReleases(context.Background(), owner, repo, &opt)
+		if err != nil {
+			return nil, fmt.Errorf("ListReleases: error retrieving releases: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListReleases: non successful response code: %s", resp.Status)
+		}
+		releases = append(releases, page...)
+		if resp.NextPage == 0 {
+			return releases, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// GetRelease returns the release of tag. Draft releases have no tag yet as
+// far as GitHub is concerned, so they are looked up among all releases.
+func (a *API) GetRelease(repo, tag string) (*github.RepositoryRelease, error) {
+	client := github.NewClient(a.client)
+	owner, name, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("GetRelease: %w", err)
+	}
+	release, resp, err := client.Repositories.GetReleaseByTag(context.Background(), owner, name, tag)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 404 {
+		releases, err := a.ListReleases(repo)
+		if err != nil {
+			return nil, fmt.Errorf("GetRelease: %w", err)
+		}
+		for _, release := range releases {
+			if release.GetTagName() == tag {
+				return release, nil
+			}
+		}
+		return nil, fmt.Errorf("GetRelease: no release for tag %s in %s", tag, repo)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("GetRelease: error retrieving release %s: %w", tag, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("GetRelease: non successful response code: %s", resp.Status)
+	}
+	return release, nil
+}
+
+// NewRelease describes a release to create. It mirrors
+// github.RepositoryRelease, which predates generated release notes.
+type NewRelease struct {
+	TagName              string `json:"tag_name"`
+	TargetCommitish      string `json:"target_commitish,omitempty"`
+	Name                 string `json:"name,omitempty"`
+	Body                 string `json:"body,omitempty"`
+	Draft                bool   `json:"draft,omitempty"`
+	Prerelease           bool   `json:"prerelease,omitempty"`
+	GenerateReleaseNotes bool   `json:"generate_release_notes,omitempty"`
+}
+
+func (a *API) CreateRelease(repo string, release *NewRelease) (*github.RepositoryRelease, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("CreateRelease: %w", err)
+	}
+	req, err := client.NewRequest("POST", fmt.Sprintf("repos/%s/%s/releases", owner, repo), release)
+	if err != nil {
+		return nil, fmt.Errorf("CreateRelease: %w", err)
+	}
+	created := new(github.RepositoryRelease)
+	resp, err := client.Do(context.Background(), req, created)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 422 {
+		return nil, fmt.Errorf("CreateRelease: can't create release %s: %s", release.TagName, validationMessage(errResp))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("CreateRelease: error creating release %s: %w", release.TagName, err)
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("CreateRelease: non successful response code: %s", resp.Status)
+	}
+	return created, nil
+}
+
+func (a *API) EditRelease(repo string, id int64, release *github.RepositoryRelease) (*github.RepositoryRelease, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("EditRelease: %w", err)
+	}
+	edited, resp, err := client.Repositories.EditRelease(context.Background(), owner, repo, id, release)
+	if err != nil {
+		return nil, fmt.Errorf("EditRelease: error editing release: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("EditRelease: non successful response code: %s", resp.Status)
+	}
+	return edited, nil
+}
+
+// DeleteRelease deletes a release and its assets. The tag is kept.
+func (a *API) DeleteRelease(repo string, id int64) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("DeleteRelease: %w", err)
+	}
+	resp, err := client.Repositories.DeleteRelease(context.Background(), owner, repo, id)
+	if err != nil {
+		return fmt.Errorf("DeleteRelease: error deleting release: %w", err)
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("DeleteRelease: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+// UploadReleaseAsset uploads size bytes read from r as the asset called name
+// of release. The content is streamed rather than read into memory first.
+func (a *API) UploadReleaseAsset(release *github.RepositoryRelease, name, contentType string, r io.Reader, size int64) (*github.ReleaseAsset, error) {
+	client := github.NewClient(a.client)
+	// The upload URL is a template ending in {?name,label}.
+	uploadURL := release.GetUploadURL()
+	if i := strings.Index(uploadURL, "{"); i >= 0 {
+		uploadURL = uploadURL[:i]
+	}
+	if uploadURL == "" {
+		return nil, fmt.Errorf("UploadReleaseAsset: release %s has no upload URL", release.GetTagName())
+	}
+	req, err := http.NewRequest("POST", uploadURL+"?name="+url.QueryEscape(name), r)
+	if err != nil {
+		return nil, fmt.Errorf("UploadReleaseAsset: %w", err)
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	asset := new(github.ReleaseAsset)
+	resp, err := client.Do(context.Background(), req, asset)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 422 {
+		return nil, fmt.Errorf("UploadReleaseAsset: can't upload %s: %s", name, validationMessage(errResp))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("UploadReleaseAsset: error uploading %s: %w", name, err)
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("UploadReleaseAsset: non successful response code: %s", resp.Status)
+	}
+	return asset, nil
+}
+
+func (a *API) DeleteReleaseAsset(repo string, id int64) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("DeleteReleaseAsset: %w", err)
+	}
+	resp, err := client.Repositories.DeleteReleaseAsset(context.Background(), owner, repo, id)
+	if err != nil {
+		return fmt.Errorf("DeleteReleaseAsset: error deleting asset: %w", err)
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("DeleteReleaseAsset: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+// RenameReleaseAsset changes the name of a release asset.
+func (a *API) RenameReleaseAsset(repo string, id int64, name string) (*github.ReleaseAsset, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("RenameReleaseAsset: %w", err)
+	}
+	asset, resp, err := client.Repositories.EditReleaseAsset(context.Background(), owner, repo, id, &github.ReleaseAsset{Name: &name})
+	if err != nil {
+		return nil, fmt.Errorf("RenameReleaseAsset: error renaming asset to %s: %w", name, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("RenameReleaseAsset: non successful response code: %s", resp.Status)
+	}
+	return asset, nil
+}
+
+// DownloadReleaseAsset returns the content of asset. The caller has to close
+// it.
+func (a *API) DownloadReleaseAsset(asset *github.ReleaseAsset) (io.ReadCloser, error) {
+	body, err := a.download(asset.GetURL(), "application/octet-stream")
+	if err != nil {
+		return nil, fmt.Errorf("DownloadReleaseAsset: error downloading %s: %w", asset.GetName(), err)
+	}
+	return body, nil
+}
@@ -0,0 +1,129 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/github"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_GetRelease_draft(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		switch {
+		case strings.HasPrefix(req.URL.Path, "/repos/TheAlgorithms/Go/releases/tags/"):
+			return &http.Response{
+				StatusCode: 404,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"message": "Not Found"}`)),
+				Header:     make(http.Header),
+				Request:    req,
+			}
+		case req.URL.Path == "/repos/TheAlgorithms/Go/releases":
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`[{"id": 1, "tag_name": "v1.0.0"}, {"id": 2, "tag_name": "v2.0.0", "draft": true}]`)),
+				Header:     make(http.Header),
+			}
+		}
+		t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		return nil
+	})
+	app := api.NewApi(cl)
+	got, err := app.GetRelease("TheAlgorithms/Go", "v2.0.0")
+	if err != nil {
+		t.Fatalf("GetRelease() error = %v", err)
+	}
+	if got.GetID() != 2 || !got.GetDraft() {
+		t.Errorf("GetRelease() = %v, want the draft", got)
+	}
+	if _, err := app.GetRelease("TheAlgorithms/Go", "v3.0.0"); err == nil {
+		t.Errorf("GetRelease() of a missing tag didn't fail")
+	}
+}
+
+func Test_api_CreateRelease(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "POST" || req.URL.Path != "/repos/TheAlgorithms/Go/releases" {
+			t.Errorf("CreateRelease request = %v %v", req.Method, req.URL)
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body["tag_name"] != "v1.0.0" || body["generate_release_notes"] != true || body["prerelease"] != nil {
+			t.Errorf("CreateRelease body = %v", body)
+		}
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"id": 1, "tag_name": "v1.0.0"}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.CreateRelease("TheAlgorithms/Go", &api.NewRelease{TagName: "v1.0.0", GenerateReleaseNotes: true})
+	if err != nil || got.GetID() != 1 {
+		t.Errorf("CreateRelease() = %v, %v", got, err)
+	}
+}
+
+func Test_api_UploadReleaseAsset(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.String() != "https://uploads.github.com/repos/TheAlgorithms/Go/releases/1/assets?name=go+1.tar.gz" {
+			t.Errorf("UploadReleaseAsset request = %v", req.URL)
+		}
+		if req.ContentLength != 5 || req.Header.Get("Content-Type") != "application/gzip" {
+			t.Errorf("UploadReleaseAsset headers = %d, %v", req.ContentLength, req.Header)
+		}
+		if body, _ := ioutil.ReadAll(req.Body); string(body) != "bytes" {
+			t.Errorf("UploadReleaseAsset body = %q", body)
+		}
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"id": 3, "name": "go.1.tar.gz", "size": 5}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	release := &github.RepositoryRelease{
+		UploadURL: github.String("https://uploads.github.com/repos/TheAlgorithms/Go/releases/1/assets{?name,label}"),
+	}
+	got, err := app.UploadReleaseAsset(release, "go 1.tar.gz", "application/gzip", strings.NewReader("bytes"), 5)
+	if err != nil || got.GetID() != 3 {
+		t.Errorf("UploadReleaseAsset() = %v, %v", got, err)
+	}
+}
+
+func Test_api_DownloadReleaseAsset(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if auth := req.Header.Get("Authorization"); auth != "" {
+			t.Errorf("storage request sent Authorization %q", auth)
+		}
+		w.Write([]byte("archive"))
+	}))
+	defer storage.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if auth := req.Header.Get("Authorization"); auth != "token secret" {
+			t.Errorf("asset request sent Authorization %q", auth)
+		}
+		if accept := req.Header.Get("Accept"); accept != "application/octet-stream" {
+			t.Errorf("asset request sent Accept %q", accept)
+		}
+		http.Redirect(w, req, storage.URL+"/archive", http.StatusFound)
+	}))
+	defer server.Close()
+
+	app := api.NewApi(api.NewClient("secret"))
+	body, err := app.DownloadReleaseAsset(&github.ReleaseAsset{URL: github.String(server.URL + "/assets/3")})
+	if err != nil {
+		t.Fatalf("DownloadReleaseAsset() error = %v", err)
+	}
+	defer body.Close()
+	if got, _ := ioutil.ReadAll(body); string(got) != "archive" {
+		t.Errorf("DownloadReleaseAsset() = %q, want archive", got)
+	}
+}
@@ -0,0 +1,264 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/github"
+)
+
+func (a *API) GetRepo(repo string) (*github.Repository, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("GetRepo: %w", err)
+	}
+	r, resp, err := client.Repositories.Get(context.Background(), owner, repo)
+	if err != nil {
+		return nil, fmt.Errorf("GetRepo: error retrieving repository: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("GetRepo: non successful response code: %s", resp.Status)
+	}
+	return r, nil
+}
+
+// DeleteBranch deletes a branch of repo on GitHub.
+func (a *API) DeleteBranch(repo, branch string) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("DeleteBranch: %w", err)
+	}
+	resp, err := client.Git.DeleteRef(context.Background(), owner, repo, "heads/"+branch)
+	if err != nil {
+		return fmt.Errorf("DeleteBranch: error deleting %s: %w", branch, err)
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("DeleteBranch: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+// CompareCommits compares head with base, which may be SHAs, branch or tag
+// names. GitHub lists at most 250 of the commits, compare len(Commits) with
+// TotalCommits to tell.
+func (a *API) CompareCommits(repo, base, head string) (*github.CommitsComparison, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("CompareCommits: %w", err)
+	}
+	comparison, resp, err := client.Repositories.CompareCommits(context.Background(), owner, repo, base, head)
+	if err != nil {
+		return nil, fmt.Errorf("CompareCommits: error comparing %s...%s: %w", base, head, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("CompareCommits: non successful response code: %s", resp.Status)
+	}
+	return comparison, nil
+}
+
+// ListCommitsBetween returns every commit reachable from head but not from
+// base, oldest first. Unlike CompareCommits it isn't limited to 250 commits.
+func (a *API) ListCommitsBetween(repo, base, head string) ([]*github.RepositoryCommit, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListCommitsBetween: %w", err)
+	}
+	var commits []*github.RepositoryCommit
+	page := 1
+	for {
+		u := fmt.Sprintf("repos/%s/%s/compare/%s...%s?per_page=100&page=%d", owner, repo, base, head, page)
+		req, err := client.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, fmt.Errorf("ListCommitsBetween: %w", err)
+		}
+		comparison := new(github.CommitsComparison)
+		resp, err := client.Do(context.Background(), req, comparison)
+		if err != nil {
+			return nil, fmt.Errorf("ListCommitsBetween: error comparing %s...%s: %w", base, head, err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListCommitsBetween: non successful response code: %s", resp.Status)
+		}
+		for i := range comparison.Commits {
+			commits = append(commits, &comparison.Commits[i])
+		}
+		if resp.NextPage == 0 {
+			return commits, nil
+		}
+		page = resp.NextPage
+	}
+}
+
+// GetFileContent returns the content of the file at path in repo at ref, the
+// default branch if ref is empty. found is false when there is no such file.
+func (a *API) GetFileContent(repo, path, ref string) (content string, found bool, err error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return "", false, fmt.Errorf("GetFileContent: %w", err)
+	}
+	opt := github.RepositoryContentGetOptions{Ref: ref}
+	file, _, resp, err := client.Repositories.GetContents(context.Background(), owner, repo, path, &opt)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 404 {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("GetFileContent: error retrieving %s: %w", path, err)
+	}
+	if resp.StatusCode != 200 {
+		return "", false, fmt.Errorf("GetFileContent: non successful response code: %s", resp.Status)
+	}
+	if file == nil {
+		return "", false, fmt.Errorf("GetFileContent: %s is a directory", path)
+	}
+	content, err = file.GetContent()
+	if err != nil {
+		return "", false, fmt.Errorf("GetFileContent: %w", err)
+	}
+	return content, true, nil
+}
+
+// GetReadme returns the README of repo, or an empty string when it has none.
+func (a *API) GetReadme(repo string) (string, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return "", fmt.Errorf("GetReadme: %w", err)
+	}
+	readme, resp, err := client.Repositories.GetReadme(context.Background(), owner, repo, nil)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 404 {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("GetReadme: error retrieving README: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("GetReadme: non successful response code: %s", resp.Status)
+	}
+	content, err := readme.GetContent()
+	if err != nil {
+		return "", fmt.Errorf("GetReadme: %w", err)
+	}
+	return content, nil
+}
+
+// ListRepos lists the repositories of a user or organisation. An empty owner
+// lists those of the authenticated user, including private ones.
+func (a *API) ListRepos(owner string) ([]*github.Repository, error) {
+	client := github.NewClient(a.client)
+	isOrg := false
+	if owner != "" {
+		user, resp, err := client.Users.Get(context.Background(), owner)
+		if err != nil {
+			return nil, fmt.Errorf("ListRepos: error retrieving %s: %w", owner, err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListRepos: non successful response code: %s", resp.Status)
+		}
+		isOrg = user.GetType() == "Organization"
+	}
+	listOpt := github.ListOptions{PerPage: 100}
+	var repos []*github.Repository
+	for {
+		var page []*github.Repository
+		var resp *github.Response
+		var err error
+		if isOrg {
+			opt := github.RepositoryListByOrgOptions{Type: "all", ListOptions: listOpt}
+			page, resp, err = client.Repositories.ListByOrg(context.Background(), owner, &opt)
+		} else {
+			opt := github.RepositoryListOptions{Type: "owner", ListOptions: listOpt}
+			page, resp, err = client.Repositories.List(context.Background(), owner, &opt)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ListRepos: error retrieving repositories: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListRepos: non successful response code: %s", resp.Status)
+		}
+		repos = append(repos, page...)
+		if resp.NextPage == 0 {
+			return repos, nil
+		}
+		listOpt.Page = resp.NextPage
+	}
+}
+
+// CreateRepo creates a repository owned by org, or by the authenticated user
+// when org is empty.
+func (a *API) CreateRepo(org string, repo *github.Repository) (*github.Repository, error) {
+	client := github.NewClient(a.client)
+	created, resp, err := client.Repositories.Create(context.Background(), org, repo)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 422 {
+		return nil, fmt.Errorf("CreateRepo: can't create %s: %s", repo.GetName(), validationMessage(errResp))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("CreateRepo: error creating %s: %w", repo.GetName(), err)
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("CreateRepo: non successful response code: %s", resp.Status)
+	}
+	return created, nil
+}
+
+// TemplateRepo describes a repository to create from a template repository.
+// go-github doesn't support templates yet.
+type TemplateRepo struct {
+	Owner       string `json:"owner,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Private     bool   `json:"private"`
+}
+
+// CreateRepoFromTemplate creates a repository with the files of template.
+// An empty Owner means the authenticated user.
+func (a *API) CreateRepoFromTemplate(template string, repo *TemplateRepo) (*github.Repository, error) {
+	client := github.NewClient(a.client)
+	owner, name, err := getOwnerAndRepo(template)
+	if err != nil {
+		return nil, fmt.Errorf("CreateRepoFromTemplate: %w", err)
+	}
+	req, err := client.NewRequest("POST", fmt.Sprintf("repos/%s/%s/generate", owner, name), repo)
+	if err != nil {
+		return nil, fmt.Errorf("CreateRepoFromTemplate: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github.baptiste-preview+json")
+	created := new(github.Repository)
+	resp, err := client.Do(context.Background(), req, created)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 422 {
+		return nil, fmt.Errorf("CreateRepoFromTemplate: can't create %s: %s", repo.Name, validationMessage(errResp))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("CreateRepoFromTemplate: error creating %s from %s: %w", repo.Name, template, err)
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("CreateRepoFromTemplate: non successful response code: %s", resp.Status)
+	}
+	return created, nil
+}
+
+// ForkRepo forks repo into org, or into the account of the authenticated user
+// when org is empty. GitHub creates the fork in the background, so it may take
+// a moment before it can be cloned.
+func (a *API) ForkRepo(repo, org string) (*github.Repository, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ForkRepo: %w", err)
+	}
+	fork, _, err := client.Repositories.CreateFork(context.Background(), owner, repo, &github.RepositoryCreateForkOptions{Organization: org})
+	var accepted *github.AcceptedError
+	if err != nil && !errors.As(err, &accepted) {
+		return nil, fmt.Errorf("ForkRepo: error forking %s/%s: %w", owner, repo, err)
+	}
+	return fork, nil
+}
@@ -0,0 +1,192 @@
+package api_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_CompareCommits(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/compare/v1.0.0...main" {
+			t.Errorf("CompareCommits request = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"status": "ahead", "ahead_by": 2, "total_commits": 2, "commits": [{"sha": "a"}, {"sha": "b"}]}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.CompareCommits("TheAlgorithms/Go", "v1.0.0", "main")
+	if err != nil {
+		t.Fatalf("CompareCommits() error = %v", err)
+	}
+	if got.GetAheadBy() != 2 || len(got.Commits) != 2 {
+		t.Errorf("CompareCommits() = %v", got)
+	}
+}
+
+func Test_api_GetReadme(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path == "/repos/TheAlgorithms/Go/readme" {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"encoding": "base64", "content": "IyBHbwo="}`)),
+				Header:     make(http.Header),
+			}
+		}
+		return &http.Response{
+			StatusCode: 404,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"message": "Not Found"}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.GetReadme("TheAlgorithms/Go")
+	if err != nil || got != "# Go\n" {
+		t.Errorf("GetReadme() = %q, %v, want # Go", got, err)
+	}
+	got, err = app.GetReadme("TheAlgorithms/Empty")
+	if err != nil || got != "" {
+		t.Errorf("GetReadme() without a README = %q, %v", got, err)
+	}
+}
+
+func Test_api_ListRepos(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		header := make(http.Header)
+		body := `[]`
+		switch req.URL.String() {
+		case "https://api.github.com/users/TheAlgorithms":
+			body = `{"login": "TheAlgorithms", "type": "Organization"}`
+		case "https://api.github.com/orgs/TheAlgorithms/repos?per_page=100&type=all":
+			header.Set("Link", `<https://api.github.com/orgs/TheAlgorithms/repos?page=2&per_page=100&type=all>; rel="next"`)
+			body = `[{"name": "Go"}]`
+		case "https://api.github.com/orgs/TheAlgorithms/repos?page=2&per_page=100&type=all":
+			body = `[{"name": "Python"}]`
+		default:
+			t.Errorf("unexpected request %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     header,
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListRepos("TheAlgorithms")
+	if err != nil {
+		t.Fatalf("ListRepos() error = %v", err)
+	}
+	if len(got) != 2 || got[1].GetName() != "Python" {
+		t.Errorf("ListRepos() = %v", got)
+	}
+}
+
+func Test_api_CreateRepoFromTemplate(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "POST" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/template/generate" {
+			t.Errorf("CreateRepoFromTemplate request = %v %v", req.Method, req.URL)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if want := `{"owner":"TheAlgorithms","name":"Zig","private":false}` + "\n"; string(body) != want {
+			t.Errorf("CreateRepoFromTemplate body = %q, want %q", body, want)
+		}
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"full_name": "TheAlgorithms/Zig"}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.CreateRepoFromTemplate("TheAlgorithms/template", &api.TemplateRepo{Owner: "TheAlgorithms", Name: "Zig"})
+	if err != nil {
+		t.Fatalf("CreateRepoFromTemplate() error = %v", err)
+	}
+	if got.GetFullName() != "TheAlgorithms/Zig" {
+		t.Errorf("CreateRepoFromTemplate() = %v", got)
+	}
+}
+
+func Test_api_ForkRepo(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "POST" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/forks" {
+			t.Errorf("ForkRepo request = %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 202,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"full_name": "octocat/Go"}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ForkRepo("TheAlgorithms/Go", "")
+	if err != nil {
+		t.Fatalf("ForkRepo() error = %v", err)
+	}
+	if got.GetFullName() != "octocat/Go" {
+		t.Errorf("ForkRepo() = %v", got)
+	}
+}
+
+func Test_api_ListCommitsBetween(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		header := make(http.Header)
+		body := `{"commits": [{"sha": "c"}]}`
+		if req.URL.Query().Get("page") == "1" {
+			header.Set("Link", `<https://api.github.com/repos/TheAlgorithms/Go/compare/v1...main?per_page=100&page=2>; rel="next"`)
+			body = `{"commits": [{"sha": "a"}, {"sha": "b"}]}`
+		}
+		if req.URL.Path != "/repos/TheAlgorithms/Go/compare/v1...main" {
+			t.Errorf("ListCommitsBetween request = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     header,
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListCommitsBetween("TheAlgorithms/Go", "v1", "main")
+	if err != nil {
+		t.Fatalf("ListCommitsBetween() error = %v", err)
+	}
+	if len(got) != 3 || got[2].GetSHA() != "c" {
+		t.Errorf("ListCommitsBetween() = %v", got)
+	}
+}
+
+func Test_api_GetFileContent(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path == "/repos/TheAlgorithms/Go/contents/.github/release.yml" {
+			if req.URL.Query().Get("ref") != "v2" {
+				t.Errorf("GetFileContent request = %v", req.URL)
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"type": "file", "encoding": "base64", "content": "IyBHbwo="}`)),
+				Header:     make(http.Header),
+			}
+		}
+		return &http.Response{
+			StatusCode: 404,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"message": "Not Found"}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})
+	app := api.NewApi(cl)
+	got, found, err := app.GetFileContent("TheAlgorithms/Go", ".github/release.yml", "v2")
+	if err != nil || !found || got != "# Go\n" {
+		t.Errorf("GetFileContent() = %q, %v, %v", got, found, err)
+	}
+	_, found, err = app.GetFileContent("TheAlgorithms/Go", ".github/release.yaml", "v2")
+	if err != nil || found {
+		t.Errorf("GetFileContent() of a missing file = %v, %v", found, err)
+	}
+}
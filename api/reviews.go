@@ -0,0 +1,254 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+// ListReviews returns the reviews of a PR in the order they were submitted.
+func (a *API) ListReviews(repo string, number int) ([]*github.PullRequestReview, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListReviews: %w", err)
+	}
+	opt := github.ListOptions{PerPage: 100}
+	var reviews []*github.PullRequestReview
+	for {
+		page, resp, err := client.PullRequests.ListReviews(context.Background(), owner, repo, number, &opt)
+		if err != nil {
+			return nil, fmt.Errorf("ListReviews: error retrieving reviews of #%d: %w", number, err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListReviews: non successful response code: %s", resp.Status)
+		}
+		reviews = append(reviews, page...)
+		if resp.NextPage == 0 {
+			return reviews, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// CreateReview submits a review of a PR. event is one of "APPROVE",
+// "REQUEST_CHANGES" or "COMMENT".
+func (a *API) CreateReview(repo string, number int, event, body string) (*github.PullRequestReview, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("CreateReview: %w", err)
+	}
+	review := &github.PullRequestReviewRequest{
+		Event: &event,
+	}
+	if body != "" {
+		review.Body = &body
+	}
+	created, resp, err := client.PullRequests.CreateReview(context.Background(), owner, repo, number, review)
+	if err != nil {
+		return nil, fmt.Errorf("CreateReview: error reviewing #%d: %w", number, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("CreateReview: non successful response code: %s", resp.Status)
+	}
+	return created, nil
+}
+
+// SubmitReview submits the pending review with the given ID. event is one of
+// "APPROVE", "REQUEST_CHANGES" or "COMMENT".
+func (a *API) SubmitReview(repo string, number int, id int64, event, body string) (*github.PullRequestReview, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("SubmitReview: %w", err)
+	}
+	review := &github.PullRequestReviewRequest{
+		Event: &event,
+	}
+	if body != "" {
+		review.Body = &body
+	}
+	submitted, resp, err := client.PullRequests.SubmitReview(context.Background(), owner, repo, number, id, review)
+	if err != nil {
+		return nil, fmt.Errorf("SubmitReview: error submitting review of #%d: %w", number, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("SubmitReview: non successful response code: %s", resp.Status)
+	}
+	return submitted, nil
+}
+
+// ReviewThread is a thread of comments on a line of the diff of a PR.
+type ReviewThread struct {
+	Path       string
+	Line       int
+	IsResolved bool
+	IsOutdated bool
+	Comments   []*ReviewComment
+}
+
+type ReviewComment struct {
+	Author    string
+	Body      string
+	DiffHunk  string
+	CreatedAt time.Time
+}
+
+type reviewThreadsResult struct {
+	Repository struct {
+		PullRequest struct {
+			ReviewThreads struct {
+				Nodes []struct {
+					Path       string `json:"path"`
+					Line       int    `json:"line"`
+					IsResolved bool   `json:"isResolved"`
+					IsOutdated bool   `json:"isOutdated"`
+					Comments   struct {
+						Nodes []struct {
+							Author struct {
+								Login string `json:"login"`
+							} `json:"author"`
+							Body      string    `json:"body"`
+							DiffHunk  string    `json:"diffHunk"`
+							CreatedAt time.Time `json:"createdAt"`
+						} `json:"nodes"`
+					} `json:"comments"`
+				} `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"reviewThreads"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
+// ListReviewThreads returns the review comment threads of a PR. Only the
+// GraphQL API tells whether a thread is resolved.
+func (a *API) ListReviewThreads(repo string, number int) ([]*ReviewThread, error) {
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListReviewThreads: %w", err)
+	}
+	query := `query($owner: String!, $repo: String!, $number: Int!, $after: String) {
+	repository(owner: $owner, name: $repo) {
+		pullRequest(number: $number) {
+			reviewThreads(first: 100, after: $after) {
+				nodes {
+					path line isResolved isOutdated
+					comments(first: 100) { nodes { author { login } body diffHunk createdAt } }
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}
+	}
+}`
+	variables := map[string]interface{}{"owner": owner, "repo": repo, "number": number}
+	var threads []*ReviewThread
+	for {
+		var result reviewThreadsResult
+		if err := a.graphQL(query, variables, &result); err != nil {
+			return nil, fmt.Errorf("ListReviewThreads: %w", err)
+		}
+		page := result.Repository.PullRequest.ReviewThreads
+		for _, node := range page.Nodes {
+			thread := &ReviewThread{
+				Path:       node.Path,
+				Line:       node.Line,
+				IsResolved: node.IsResolved,
+				IsOutdated: node.IsOutdated,
+			}
+			for _, comment := range node.Comments.Nodes {
+				thread.Comments = append(thread.Comments, &ReviewComment{
+					Author:    comment.Author.Login,
+					Body:      comment.Body,
+					DiffHunk:  comment.DiffHunk,
+					CreatedAt: comment.CreatedAt,
+				})
+			}
+			threads = append(threads, thread)
+		}
+		if !page.PageInfo.HasNextPage {
+			return threads, nil
+		}
+		variables["after"] = page.PageInfo.EndCursor
+	}
+}
+
+// DraftComment is a comment on a line of the diff of a PR. Side is "RIGHT"
+// for the new version of the file and "LEFT" for the old one.
+type DraftComment struct {
+	Path string `json:"path"`
+	Line int    `json:"line"`
+	Side string `json:"side,omitempty"`
+	Body string `json:"body"`
+}
+
+type pendingReviewResult struct {
+	Repository struct {
+		PullRequest struct {
+			ID      string `json:"id"`
+			Reviews struct {
+				Nodes []struct {
+					ID string `json:"id"`
+				} `json:"nodes"`
+			} `json:"reviews"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
+// AddDraftComment adds comment to the pending review of the authenticated
+// user, starting one when there isn't any. Nobody else sees the comments of a
+// pending review until it is submitted, so they are batched into one review.
+func (a *API) AddDraftComment(repo string, number int, comment *DraftComment) error {
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("AddDraftComment: %w", err)
+	}
+	// Only the viewer's own reviews can be pending.
+	query := `query($owner: String!, $repo: String!, $number: Int!) {
+	repository(owner: $owner, name: $repo) {
+		pullRequest(number: $number) {
+			id
+			reviews(states: PENDING, first: 1) { nodes { id } }
+		}
+	}
+}`
+	var pending pendingReviewResult
+	if err := a.graphQL(query, map[string]interface{}{"owner": owner, "repo": repo, "number": number}, &pending); err != nil {
+		return fmt.Errorf("AddDraftComment: %w", err)
+	}
+	pr := pending.Repository.PullRequest
+	if len(pr.Reviews.Nodes) == 0 {
+		mutation := `mutation($input: AddPullRequestReviewInput!) {
+	addPullRequestReview(input: $input) { clientMutationId }
+}`
+		input := map[string]interface{}{
+			"pullRequestId": pr.ID,
+			"threads":       []*DraftComment{comment},
+		}
+		if err := a.graphQL(mutation, map[string]interface{}{"input": input}, nil); err != nil {
+			return fmt.Errorf("AddDraftComment: %w", err)
+		}
+		return nil
+	}
+	mutation := `mutation($input: AddPullRequestReviewThreadInput!) {
+	addPullRequestReviewThread(input: $input) { clientMutationId }
+}`
+	input := map[string]interface{}{
+		"pullRequestReviewId": pr.Reviews.Nodes[0].ID,
+		"path":                comment.Path,
+		"line":                comment.Line,
+		"body":                comment.Body,
+	}
+	if comment.Side != "" {
+		input["side"] = comment.Side
+	}
+	if err := a.graphQL(mutation, map[string]interface{}{"input": input}, nil); err != nil {
+		return fmt.Errorf("AddDraftComment: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_ListReviews(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		header := make(http.Header)
+		body := `[{"id": 1, "state": "COMMENTED"}]`
+		switch req.URL.String() {
+		case "https://api.github.com/repos/TheAlgorithms/Go/pulls/5/reviews?per_page=100":
+			header.Set("Link", `<https://api.github.com/repos/TheAlgorithms/Go/pulls/5/reviews?page=2&per_page=100>; rel="next"`)
+		case "https://api.github.com/repos/TheAlgorithms/Go/pulls/5/reviews?page=2&per_page=100":
+			body = `[{"id": 2, "state": "APPROVED"}]`
+		default:
+			t.Errorf("unexpected request %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     header,
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListReviews("TheAlgorithms/Go", 5)
+	if err != nil {
+		t.Fatalf("ListReviews() error = %v", err)
+	}
+	if len(got) != 2 || got[1].GetState() != "APPROVED" {
+		t.Errorf("ListReviews() = %v", got)
+	}
+}
+
+func Test_api_CreateReview(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "POST" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/pulls/5/reviews" {
+			t.Errorf("CreateReview request = %v %v", req.Method, req.URL)
+		}
+		var review map[string]interface{}
+		if err := json.NewDecoder(req.Body).Decode(&review); err != nil {
+			t.Fatal(err)
+		}
+		if review["event"] != "REQUEST_CHANGES" || review["body"] != "Needs tests" {
+			t.Errorf("CreateReview body = %v", review)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"id": 1, "state": "CHANGES_REQUESTED"}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.CreateReview("TheAlgorithms/Go", 5, "REQUEST_CHANGES", "Needs tests")
+	if err != nil {
+		t.Fatalf("CreateReview() error = %v", err)
+	}
+	if got.GetState() != "CHANGES_REQUESTED" {
+		t.Errorf("CreateReview() state = %q", got.GetState())
+	}
+}
+
+func Test_api_SubmitReview(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "POST" || req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/pulls/5/reviews/7/events" {
+			t.Errorf("SubmitReview request = %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"id": 7, "state": "APPROVED"}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.SubmitReview("TheAlgorithms/Go", 5, 7, "APPROVE", "")
+	if err != nil {
+		t.Fatalf("SubmitReview() error = %v", err)
+	}
+	if got.GetState() != "APPROVED" {
+		t.Errorf("SubmitReview() state = %q", got.GetState())
+	}
+}
+
+func Test_api_ListReviewThreads(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		var query struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&query); err != nil {
+			t.Fatal(err)
+		}
+		body := `{"data": {"repository": {"pullRequest": {"reviewThreads": {
+			"nodes": [{"path": "a.go", "line": 1, "comments": {"nodes": [{"author": {"login": "alice"}, "body": "Hm"}]}}],
+			"pageInfo": {"hasNextPage": true, "endCursor": "c1"}}}}}}`
+		if query.Variables["after"] == "c1" {
+			body = `{"data": {"repository": {"pullRequest": {"reviewThreads": {
+				"nodes": [{"path": "b.go", "line": 2, "isResolved": true}]}}}}}`
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListReviewThreads("TheAlgorithms/Go", 5)
+	if err != nil {
+		t.Fatalf("ListReviewThreads() error = %v", err)
+	}
+	want := []*api.ReviewThread{
+		{Path: "a.go", Line: 1, Comments: []*api.ReviewComment{{Author: "alice", Body: "Hm"}}},
+		{Path: "b.go", Line: 2, IsResolved: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListReviewThreads() = %v, want %v", got, want)
+	}
+}
+
+func Test_api_AddDraftComment(t *testing.T) {
+	for _, pending := range []string{"", "PRR_1"} {
+		var mutations []map[string]interface{}
+		cl := newTestClient(func(req *http.Request) *http.Response {
+			var query struct {
+				Query     string                 `json:"query"`
+				Variables map[string]interface{} `json:"variables"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&query); err != nil {
+				t.Fatal(err)
+			}
+			body := `{"data": {}}`
+			if strings.HasPrefix(query.Query, "query") {
+				nodes := `[]`
+				if pending != "" {
+					nodes = `[{"id": "` + pending + `"}]`
+				}
+				body = `{"data": {"repository": {"pullRequest": {"id": "PR_1", "reviews": {"nodes": ` + nodes + `}}}}}`
+			} else {
+				mutations = append(mutations, query.Variables["input"].(map[string]interface{}))
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+				Header:     make(http.Header),
+			}
+		})
+		app := api.NewApi(cl)
+		err := app.AddDraftComment("TheAlgorithms/Go", 5, &api.DraftComment{Path: "a.go", Line: 3, Side: "RIGHT", Body: "Hm"})
+		if err != nil {
+			t.Fatalf("AddDraftComment() error = %v", err)
+		}
+		if len(mutations) != 1 {
+			t.Fatalf("AddDraftComment() made %d mutations", len(mutations))
+		}
+		if pending == "" {
+			thread := map[string]interface{}{"path": "a.go", "line": 3.0, "side": "RIGHT", "body": "Hm"}
+			want := map[string]interface{}{"pullRequestId": "PR_1", "threads": []interface{}{thread}}
+			if !reflect.DeepEqual(mutations[0], want) {
+				t.Errorf("AddDraftComment() without a pending review input = %v, want %v", mutations[0], want)
+			}
+		} else if mutations[0]["pullRequestReviewId"] != pending || mutations[0]["line"] != 3.0 {
+			t.Errorf("AddDraftComment() with a pending review input = %v", mutations[0])
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/github"
+)
+
+// SearchIssues returns up to limit issues and PRs matching query, which uses
+// the GitHub search syntax, eg "repo:owner/repo is:issue label:stale". A limit
+// of zero returns every match, although GitHub only ever returns the first
+// 1000 results of a search. incomplete reports whether GitHub timed out before
+// finding every match.
+func (a *API) SearchIssues(query string, limit int) (issues []*github.Issue, incomplete bool, err error) {
+	client := github.NewClient(a.client)
+	opt := github.SearchOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	if limit > 0 && limit < opt.PerPage {
+		opt.PerPage = limit
+	}
+	for {
+		result, resp, err := client.Search.Issues(context.Background(), query, &opt)
+		if err != nil {
+			return nil, false, fmt.Errorf("SearchIssues: error searching issues: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, false, fmt.Errorf("SearchIssues: non successful response code: %s", resp.Status)
+		}
+		for i := range result.Issues {
+			issues = append(issues, &result.Issues[i])
+		}
+		incomplete = incomplete || result.GetIncompleteResults()
+		if limit > 0 && len(issues) >= limit {
+			return issues[:limit], incomplete, nil
+		}
+		if resp.NextPage == 0 {
+			return issues, incomplete, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,43 @@
+package api_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_SearchIssues(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		header := make(http.Header)
+		body := `{"total_count": 2, "incomplete_results": false, "items": [{"number": 1}]}`
+		query := req.URL.Query()
+		if req.URL.Path != "/search/issues" || query.Get("q") != "repo:TheAlgorithms/Go label:stale" {
+			t.Errorf("SearchIssues unexpected URL = %v", req.URL)
+		}
+		switch query.Get("page") {
+		case "":
+			header.Set("Link", `<https://api.github.com/search/issues?page=2&per_page=100&q=repo%3ATheAlgorithms%2FGo+label%3Astale>; rel="next"`)
+		case "2":
+			body = `{"total_count": 2, "incomplete_results": true, "items": [{"number": 2}]}`
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     header,
+		}
+	})
+	app := api.NewApi(cl)
+	got, incomplete, err := app.SearchIssues("repo:TheAlgorithms/Go label:stale", 0)
+	if err != nil {
+		t.Fatalf("SearchIssues() error = %v", err)
+	}
+	if len(got) != 2 || got[0].GetNumber() != 1 || got[1].GetNumber() != 2 {
+		t.Errorf("SearchIssues() got %v, want issues 1 and 2", got)
+	}
+	if !incomplete {
+		t.Errorf("SearchIssues() incomplete = false, want true")
+	}
+}
@@ -0,0 +1,282 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/go-github/github"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// SecretScope is where Actions secrets and variables are kept: a repository,
+// an environment of a repository or an organisation.
+type SecretScope struct {
+	Repo        string
+	Environment string
+	Org         string
+}
+
+// path returns the API path of the secrets or variables of the scope.
+func (s SecretScope) path(kind string) (string, error) {
+	if s.Org != "" {
+		return fmt.Sprintf("orgs/%s/actions/%s", url.PathEscape(s.Org), kind), nil
+	}
+	owner, repo, err := getOwnerAndRepo(s.Repo)
+	if err != nil {
+		return "", err
+	}
+	if s.Environment != "" {
+		return fmt.Sprintf("repos/%s/%s/environments/%s/%s", owner, repo, url.PathEscape(s.Environment), kind), nil
+	}
+	return fmt.Sprintf("repos/%s/%s/actions/%s", owner, repo, kind), nil
+}
+
+// Secret is an encrypted Actions secret. Its value can't be read back.
+type Secret struct {
+	Name       string    `json:"name"`
+	Visibility string    `json:"visibility,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Variable is a plain text Actions variable.
+type Variable struct {
+	Name       string    `json:"name"`
+	Value      string    `json:"value"`
+	Visibility string    `json:"visibility,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// PublicKey is the key secrets of a scope have to be encrypted with.
+type PublicKey struct {
+	KeyID string `json:"key_id"`
+	Key   string `json:"key"`
+}
+
+func (a *API) ListSecrets(scope SecretScope) ([]*Secret, error) {
+	client := github.NewClient(a.client)
+	path, err := scope.path("secrets")
+	if err != nil {
+		return nil, fmt.Errorf("ListSecrets: %w", err)
+	}
+	page := 1
+	var secrets []*Secret
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("%s?per_page=100&page=%d", path, page), nil)
+		if err != nil {
+			return nil, fmt.Errorf("ListSecrets: %w", err)
+		}
+		var result struct {
+			Secrets []*Secret `json:"secrets"`
+		}
+		resp, err := client.Do(context.Background(), req, &result)
+		if err != nil {
+			return nil, fmt.Errorf("ListSecrets: error retrieving secrets: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListSecrets: non successful response code: %s", resp.Status)
+		}
+		secrets = append(secrets, result.Secrets...)
+		if resp.NextPage == 0 {
+			return secrets, nil
+		}
+		page = resp.NextPage
+	}
+}
+
+func (a *API) GetSecretsPublicKey(scope SecretScope) (*PublicKey, error) {
+	client := github.NewClient(a.client)
+	path, err := scope.path("secrets")
+	if err != nil {
+		return nil, fmt.Errorf("GetSecretsPublicKey: %w", err)
+	}
+	req, err := client.NewRequest("GET", path+"/public-key", nil)
+	if err != nil {
+		return nil, fmt.Errorf("GetSecretsPublicKey: %w", err)
+	}
+	key := new(PublicKey)
+	resp, err := client.Do(context.Background(), req, key)
+	if err != nil {
+		return nil, fmt.Errorf("GetSecretsPublicKey: error retrieving public key: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("GetSecretsPublicKey: non successful response code: %s", resp.Status)
+	}
+	return key, nil
+}
+
+// EncryptSecret encrypts value for key the way libsodium's crypto_box_seal
+// does, which is what GitHub expects secrets to be encrypted with.
+func EncryptSecret(key *PublicKey, value []byte) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(key.Key)
+	if err != nil || len(decoded) != 32 {
+		return "", fmt.Errorf("EncryptSecret: invalid public key %q", key.Key)
+	}
+	var recipient [32]byte
+	copy(recipient[:], decoded)
+	sealed, err := box.SealAnonymous(nil, value, &recipient, rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("EncryptSecret: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// SetSecret creates or updates the secret called name, encrypting value with
+// key first. visibility is only used for organisation secrets, where it is
+// one of all, private or selected.
+func (a *API) SetSecret(scope SecretScope, key *PublicKey, name string, value []byte, visibility string) error {
+	client := github.NewClient(a.client)
+	path, err := scope.path("secrets")
+	if err != nil {
+		return fmt.Errorf("SetSecret: %w", err)
+	}
+	encrypted, err := EncryptSecret(key, value)
+	if err != nil {
+		return fmt.Errorf("SetSecret: %w", err)
+	}
+	body := struct {
+		EncryptedValue string `json:"encrypted_value"`
+		KeyID          string `json:"key_id"`
+		Visibility     string `json:"visibility,omitempty"`
+	}{EncryptedValue: encrypted, KeyID: key.KeyID}
+	if scope.Org != "" {
+		body.Visibility = visibility
+	}
+	req, err := client.NewRequest("PUT", path+"/"+url.PathEscape(name), &body)
+	if err != nil {
+		return fmt.Errorf("SetSecret: %w", err)
+	}
+	resp, err := client.Do(context.Background(), req, nil)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 422 {
+		return fmt.Errorf("SetSecret: can't set secret %s: %s", name, validationMessage(errResp))
+	}
+	if err != nil {
+		return fmt.Errorf("SetSecret: error setting secret %s: %w", name, err)
+	}
+	// 201 when the secret is created, 204 when it is updated.
+	if resp.StatusCode != 201 && resp.StatusCode != 204 {
+		return fmt.Errorf("SetSecret: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+func (a *API) DeleteSecret(scope SecretScope, name string) error {
+	client := github.NewClient(a.client)
+	path, err := scope.path("secrets")
+	if err != nil {
+		return fmt.Errorf("DeleteSecret: %w", err)
+	}
+	req, err := client.NewRequest("DELETE", path+"/"+url.PathEscape(name), nil)
+	if err != nil {
+		return fmt.Errorf("DeleteSecret: %w", err)
+	}
+	resp, err := client.Do(context.Background(), req, nil)
+	if err != nil {
+		return fmt.Errorf("DeleteSecret: error deleting secret %s: %w", name, err)
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("DeleteSecret: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+func (a *API) ListVariables(scope SecretScope) ([]*Variable, error) {
+	client := github.NewClient(a.client)
+	path, err := scope.path("variables")
+	if err != nil {
+		return nil, fmt.Errorf("ListVariables: %w", err)
+	}
+	page := 1
+	var variables []*Variable
+	for {
+		// Variables are listed at most 30 per page.
+		req, err := client.NewRequest("GET", fmt.Sprintf("%s?per_page=30&page=%d", path, page), nil)
+		if err != nil {
+			return nil, fmt.Errorf("ListVariables: %w", err)
+		}
+		var result struct {
+			Variables []*Variable `json:"variables"`
+		}
+		resp, err := client.Do(context.Background(), req, &result)
+		if err != nil {
+			return nil, fmt.Errorf("ListVariables: error retrieving variables: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListVariables: non successful response code: %s", resp.Status)
+		}
+		variables = append(variables, result.Variables...)
+		if resp.NextPage == 0 {
+			return variables, nil
+		}
+		page = resp.NextPage
+	}
+}
+
+// SetVariable updates the variable called name, or creates it if it doesn't
+// exist yet. visibility is only used for organisation variables.
+func (a *API) SetVariable(scope SecretScope, name, value, visibility string) error {
+	client := github.NewClient(a.client)
+	path, err := scope.path("variables")
+	if err != nil {
+		return fmt.Errorf("SetVariable: %w", err)
+	}
+	body := struct {
+		Name       string `json:"name"`
+		Value      string `json:"value"`
+		Visibility string `json:"visibility,omitempty"`
+	}{Name: name, Value: value}
+	if scope.Org != "" {
+		body.Visibility = visibility
+	}
+	req, err := client.NewRequest("PATCH", path+"/"+url.PathEscape(name), &body)
+	if err != nil {
+		return fmt.Errorf("SetVariable: %w", err)
+	}
+	resp, err := client.Do(context.Background(), req, nil)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 404 {
+		req, err = client.NewRequest("POST", path, &body)
+		if err != nil {
+			return fmt.Errorf("SetVariable: %w", err)
+		}
+		resp, err = client.Do(context.Background(), req, nil)
+	}
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 422 {
+		return fmt.Errorf("SetVariable: can't set variable %s: %s", name, validationMessage(errResp))
+	}
+	if err != nil {
+		return fmt.Errorf("SetVariable: error setting variable %s: %w", name, err)
+	}
+	// 201 when the variable is created, 204 when it is updated.
+	if resp.StatusCode != 201 && resp.StatusCode != 204 {
+		return fmt.Errorf("SetVariable: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+func (a *API) DeleteVariable(scope SecretScope, name string) error {
+	client := github.NewClient(a.client)
+	path, err := scope.path("variables")
+	if err != nil {
+		return fmt.Errorf("DeleteVariable: %w", err)
+	}
+	req, err := client.NewRequest("DELETE", path+"/"+url.PathEscape(name), nil)
+	if err != nil {
+		return fmt.Errorf("DeleteVariable: %w", err)
+	}
+	resp, err := client.Do(context.Background(), req, nil)
+	if err != nil {
+		return fmt.Errorf("DeleteVariable: error deleting variable %s: %w", name, err)
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("DeleteVariable: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
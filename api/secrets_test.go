@@ -0,0 +1,124 @@
+package api_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/tjgurwara99/ghcli/api"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func Test_api_EncryptSecret(t *testing.T) {
+	public, private, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := &api.PublicKey{KeyID: "1", Key: base64.StdEncoding.EncodeToString(public[:])}
+	encrypted, err := api.EncryptSecret(key, []byte("hunter2"))
+	if err != nil {
+		t.Fatalf("EncryptSecret() error = %v", err)
+	}
+	sealed, _ := base64.StdEncoding.DecodeString(encrypted)
+	opened, ok := box.OpenAnonymous(nil, sealed, public, private)
+	if !ok || string(opened) != "hunter2" {
+		t.Errorf("EncryptSecret() can't be opened: %q, %v", opened, ok)
+	}
+	if _, err := api.EncryptSecret(&api.PublicKey{Key: "c2hvcnQ="}, []byte("hunter2")); err == nil {
+		t.Errorf("EncryptSecret() with a short key didn't fail")
+	}
+}
+
+func Test_api_SetSecret(t *testing.T) {
+	public, _, _ := box.GenerateKey(rand.Reader)
+	key := &api.PublicKey{KeyID: "42", Key: base64.StdEncoding.EncodeToString(public[:])}
+	tests := []struct {
+		scope      api.SecretScope
+		path       string
+		visibility string
+	}{
+		{api.SecretScope{Repo: "TheAlgorithms/Go"}, "/repos/TheAlgorithms/Go/actions/secrets/TOKEN", ""},
+		{api.SecretScope{Repo: "TheAlgorithms/Go", Environment: "production"}, "/repos/TheAlgorithms/Go/environments/production/secrets/TOKEN", ""},
+		{api.SecretScope{Org: "TheAlgorithms"}, "/orgs/TheAlgorithms/actions/secrets/TOKEN", "all"},
+	}
+	for _, tt := range tests {
+		cl := newTestClient(func(req *http.Request) *http.Response {
+			if req.Method != "PUT" || req.URL.Path != tt.path {
+				t.Errorf("SetSecret request = %v %v, want %v", req.Method, req.URL.Path, tt.path)
+			}
+			var body map[string]string
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if body["key_id"] != "42" || body["encrypted_value"] == "" || body["visibility"] != tt.visibility {
+				t.Errorf("SetSecret body = %v", body)
+			}
+			return &http.Response{
+				StatusCode: 201,
+				Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+				Header:     make(http.Header),
+			}
+		})
+		app := api.NewApi(cl)
+		if err := app.SetSecret(tt.scope, key, "TOKEN", []byte("hunter2"), "all"); err != nil {
+			t.Errorf("SetSecret() error = %v", err)
+		}
+	}
+}
+
+func Test_api_SetVariable(t *testing.T) {
+	var requests []string
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		if req.Method == "PATCH" {
+			return &http.Response{
+				StatusCode: 404,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"message": "Not Found"}`)),
+				Header:     make(http.Header),
+				Request:    req,
+			}
+		}
+		var body map[string]string
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body["name"] != "REGION" || body["value"] != "eu-west-1" {
+			t.Errorf("SetVariable body = %v", body)
+		}
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	if err := app.SetVariable(api.SecretScope{Repo: "TheAlgorithms/Go"}, "REGION", "eu-west-1", ""); err != nil {
+		t.Errorf("SetVariable() error = %v", err)
+	}
+	want := []string{"PATCH /repos/TheAlgorithms/Go/actions/variables/REGION", "POST /repos/TheAlgorithms/Go/actions/variables"}
+	if len(requests) != 2 || requests[0] != want[0] || requests[1] != want[1] {
+		t.Errorf("SetVariable requests = %v, want %v", requests, want)
+	}
+}
+
+func Test_api_ListSecrets(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/orgs/TheAlgorithms/actions/secrets" {
+			t.Errorf("ListSecrets request = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"total_count": 1, "secrets": [{"name": "TOKEN", "visibility": "all"}]}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListSecrets(api.SecretScope{Org: "TheAlgorithms"})
+	if err != nil || len(got) != 1 || got[0].Name != "TOKEN" || got[0].Visibility != "all" {
+		t.Errorf("ListSecrets() = %v, %v", got, err)
+	}
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/github"
+)
+
+// GetCombinedStatus returns the combined commit status of ref, which may be a
+// SHA, branch or tag name.
+func (a *API) GetCombinedStatus(repo, ref string) (*github.CombinedStatus, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("GetCombinedStatus: %w", err)
+	}
+	status, resp, err := client.Repositories.GetCombinedStatus(context.Background(), owner, repo, ref, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, fmt.Errorf("GetCombinedStatus: error retrieving status of %s: %w", ref, err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("GetCombinedStatus: non successful response code: %s", resp.Status)
+	}
+	return status, nil
+}
@@ -0,0 +1,31 @@
+package api_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_GetCombinedStatus(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/commits/abc123/status?per_page=100" {
+			t.Errorf("GetCombinedStatus URL = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"state": "failure", "total_count": 1, "statuses": [{"state": "failure", "context": "ci"}]}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.GetCombinedStatus("TheAlgorithms/Go", "abc123")
+	if err != nil {
+		t.Fatalf("GetCombinedStatus() error = %v", err)
+	}
+	if got.GetState() != "failure" || len(got.Statuses) != 1 {
+		t.Errorf("GetCombinedStatus() got %v", got)
+	}
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+// Workflow is a GitHub Actions workflow, defined by a file in
+// .github/workflows.
+type Workflow struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Path      string    `json:"path"`
+	State     string    `json:"state"`
+	HTMLURL   string    `json:"html_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (a *API) ListWorkflows(repo string) ([]*Workflow, error) {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return nil, fmt.Errorf("ListWorkflows: %w", err)
+	}
+	page := 1
+	var workflows []*Workflow
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("repos/%s/%s/actions/workflows?per_page=100&page=%d", owner, repo, page), nil)
+		if err != nil {
+			return nil, fmt.Errorf("ListWorkflows: %w", err)
+		}
+		var result struct {
+			Workflows []*Workflow `json:"workflows"`
+		}
+		resp, err := client.Do(context.Background(), req, &result)
+		if err != nil {
+			return nil, fmt.Errorf("ListWorkflows: error retrieving workflows: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("ListWorkflows: non successful response code: %s", resp.Status)
+		}
+		workflows = append(workflows, result.Workflows...)
+		if resp.NextPage == 0 {
+			return workflows, nil
+		}
+		page = resp.NextPage
+	}
+}
+
+// SetWorkflowEnabled enables or disables the workflow with the given ID or
+// file name. Disabled workflows aren't triggered by any event.
+func (a *API) SetWorkflowEnabled(repo, workflow string, enabled bool) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("SetWorkflowEnabled: %w", err)
+	}
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+	req, err := client.NewRequest("PUT", fmt.Sprintf("repos/%s/%s/actions/workflows/%s/%s", owner, repo, url.PathEscape(workflow), action), nil)
+	if err != nil {
+		return fmt.Errorf("SetWorkflowEnabled: %w", err)
+	}
+	resp, err := client.Do(context.Background(), req, nil)
+	if err != nil {
+		return fmt.Errorf("SetWorkflowEnabled: error trying to %s workflow %s: %w", action, workflow, err)
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("SetWorkflowEnabled: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
+
+// DispatchWorkflow triggers the workflow_dispatch event of the workflow with
+// the given ID or file name on ref, with the given inputs.
+func (a *API) DispatchWorkflow(repo, workflow, ref string, inputs map[string]string) error {
+	client := github.NewClient(a.client)
+	owner, repo, err := getOwnerAndRepo(repo)
+	if err != nil {
+		return fmt.Errorf("DispatchWorkflow: %w", err)
+	}
+	body := struct {
+		Ref    string            `json:"ref"`
+		Inputs map[string]string `json:"inputs,omitempty"`
+	}{ref, inputs}
+	req, err := client.NewRequest("POST", fmt.Sprintf("repos/%s/%s/actions/workflows/%s/dispatches", owner, repo, url.PathEscape(workflow)), &body)
+	if err != nil {
+		return fmt.Errorf("DispatchWorkflow: %w", err)
+	}
+	resp, err := client.Do(context.Background(), req, nil)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response.StatusCode == 422 {
+		return fmt.Errorf("DispatchWorkflow: can't run workflow %s: %s", workflow, validationMessage(errResp))
+	}
+	if err != nil {
+		return fmt.Errorf("DispatchWorkflow: error running workflow %s: %w", workflow, err)
+	}
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("DispatchWorkflow: non successful response code: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func Test_api_ListWorkflows(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/repos/TheAlgorithms/Go/actions/workflows" {
+			t.Errorf("ListWorkflows request = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"total_count": 1, "workflows": [{"id": 5, "name": "CI", "path": ".github/workflows/ci.yml", "state": "active"}]}`)),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	got, err := app.ListWorkflows("TheAlgorithms/Go")
+	if err != nil || len(got) != 1 || got[0].Path != ".github/workflows/ci.yml" {
+		t.Errorf("ListWorkflows() = %v, %v", got, err)
+	}
+}
+
+func Test_api_SetWorkflowEnabled(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "PUT" || req.URL.Path != "/repos/TheAlgorithms/Go/actions/workflows/5/disable" {
+			t.Errorf("SetWorkflowEnabled request = %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 204,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	if err := app.SetWorkflowEnabled("TheAlgorithms/Go", "5", false); err != nil {
+		t.Errorf("SetWorkflowEnabled() error = %v", err)
+	}
+}
+
+func Test_api_DispatchWorkflow(t *testing.T) {
+	cl := newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "POST" || req.URL.Path != "/repos/TheAlgorithms/Go/actions/workflows/5/dispatches" {
+			t.Errorf("DispatchWorkflow request = %v %v", req.Method, req.URL)
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]interface{}{"ref": "main", "inputs": map[string]interface{}{"env": "staging"}}
+		if !reflect.DeepEqual(body, want) {
+			t.Errorf("DispatchWorkflow body = %v, want %v", body, want)
+		}
+		return &http.Response{
+			StatusCode: 204,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+			Header:     make(http.Header),
+		}
+	})
+	app := api.NewApi(cl)
+	if err := app.DispatchWorkflow("TheAlgorithms/Go", "5", "main", map[string]string{"env": "staging"}); err != nil {
+		t.Errorf("DispatchWorkflow() error = %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newArtifactListCmd() *cobra.Command {
+	var repo string
+	var asJSON bool
+	var artifactListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the artifacts of the stated repository",
+		Long:  `List the artifacts of the workflow runs of the stated repository with their size and expiry, newest first.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			artifacts, err := ghApi.ListArtifacts(repo)
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				return printJSON(cmd.OutOrStdout(), artifacts)
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			for _, artifact := range artifacts {
+				colour, expiry := green, "expires "+artifact.ExpiresAt.Format("2006-01-02")
+				if artifact.Expired {
+					colour, expiry = gray, "expired"
+				}
+				fmt.Fprintf(w, "%s%s\t%s\t%s\trun %d\t%s%s\n", colour, artifact.Name, formatSize(artifact.SizeInBytes),
+					expiry, artifact.WorkflowRun.ID, artifact.WorkflowRun.HeadBranch, reset)
+			}
+			return w.Flush()
+		},
+	}
+	artifactListCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to list the artifacts of")
+	artifactListCmd.Flags().BoolVar(&asJSON, "json", false, "print the artifacts as JSON")
+	_ = artifactListCmd.MarkFlagRequired("repo")
+	return artifactListCmd
+}
+
+func init() {
+	artifactCmd.AddCommand(newArtifactListCmd())
+}
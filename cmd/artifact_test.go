@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFormatSize(t *testing.T) {
+	for bytes, want := range map[int64]string{999: "999 B", 1500: "1.5 kB", 2500000: "2.5 MB"} {
+		if got := formatSize(bytes); got != want {
+			t.Errorf("formatSize(%d) = %q, want %q", bytes, got, want)
+		}
+	}
+}
+
+func TestExtractZip(t *testing.T) {
+	extract := func(files map[string]string) (string, error) {
+		data := newTestZip(t, files)
+		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		dir := filepath.Join(t.TempDir(), "out")
+		return dir, extractZip(archive, dir)
+	}
+
+	dir, err := extract(map[string]string{"bin/app": "binary", "README": "read me"})
+	if err != nil {
+		t.Fatalf("extractZip() error = %v", err)
+	}
+	if content, _ := os.ReadFile(filepath.Join(dir, "bin", "app")); string(content) != "binary" {
+		t.Errorf("extracted %q, want binary", content)
+	}
+
+	for _, name := range []string{"../evil", "bin/../../evil", "/etc/evil", `..\evil`} {
+		dir, err := extract(map[string]string{"good": "good", name: "evil"})
+		if err == nil {
+			t.Errorf("extractZip() of %q didn't fail", name)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "good")); !os.IsNotExist(err) {
+			t.Errorf("extractZip() of %q extracted the other files", name)
+		}
+		if _, err := os.Stat(filepath.Join(filepath.Dir(dir), "evil")); !os.IsNotExist(err) {
+			t.Errorf("extractZip() of %q wrote outside of the directory", name)
+		}
+	}
+}
+
+func TestRunDownloadCmd(t *testing.T) {
+	coverage := newTestZip(t, map[string]string{"coverage.out": "mode: set"})
+	binaries := newTestZip(t, map[string]string{"linux/app": "binary"})
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		var body []byte
+		switch req.URL.Path {
+		case "/repos/TheAlgorithms/Go/actions/runs/7/artifacts":
+			body = []byte(`{"artifacts": [
+				{"name": "coverage", "archive_download_url": "https://api.github.com/artifacts/1/zip"},
+				{"name": "binaries", "archive_download_url": "https://api.github.com/artifacts/2/zip"},
+				{"name": "old", "expired": true}]}`)
+		case "/artifacts/1/zip":
+			body = coverage
+		case "/artifacts/2/zip":
+			body = binaries
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(body)),
+			Header:     make(http.Header),
+		}
+	})
+	dir := t.TempDir()
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"run", "download", "7", "-r", "TheAlgorithms/Go", "-D", dir})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := green + "Downloaded coverage to " + filepath.Join(dir, "coverage") + "\n" +
+		green + "Downloaded binaries to " + filepath.Join(dir, "binaries") + "\n"
+	if got := buff.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if content, _ := os.ReadFile(filepath.Join(dir, "binaries", "linux", "app")); string(content) != "binary" {
+		t.Errorf("extracted %q, want binary", content)
+	}
+}
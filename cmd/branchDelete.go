@@ -0,0 +1,109 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// checkBranchMerged returns why branch, whose last commit is sha, shouldn't be
+// deleted given the PRs it is the head of, or "" when a merged PR contains
+// all of its commits.
+func checkBranchMerged(branch, sha string, prs []*github.PullRequest) string {
+	var merged *github.PullRequest
+	for _, pr := range prs {
+		if pr.GetState() == "open" {
+			return fmt.Sprintf("%s is the head of open PR #%d", branch, pr.GetNumber())
+		}
+		if pr.MergedAt != nil && pr.GetHead().GetSHA() == sha {
+			merged = pr
+		}
+	}
+	if merged != nil {
+		return ""
+	}
+	for _, pr := range prs {
+		if pr.MergedAt != nil {
+			return fmt.Sprintf("%s has commits which weren't in PR #%d when it was merged", branch, pr.GetNumber())
+		}
+	}
+	return fmt.Sprintf("%s has no merged PR", branch)
+}
+
+func newBranchDeleteCmd() *cobra.Command {
+	var repo string
+	var force bool
+	var branchDeleteCmd = &cobra.Command{
+		Use:   "delete <branch>",
+		Short: "Delete a merged branch",
+		Long: `Delete a branch on GitHub. The default branch is never deleted, and other
+branches only when their last commit was merged with a PR unless --force is
+given.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			name := args[0]
+			ghApi := api.NewApi(client)
+			r, err := ghApi.GetRepo(repo)
+			if err != nil {
+				return err
+			}
+			if name == r.GetDefaultBranch() {
+				return fmt.Errorf("refusing to delete %s, the default branch of %s", name, repo)
+			}
+			if !force {
+				branch, err := ghApi.GetBranch(repo, name)
+				if err != nil {
+					return err
+				}
+				prs, err := ghApi.ListHeadPRs(repo, name)
+				if err != nil {
+					return err
+				}
+				if reason := checkBranchMerged(name, branch.GetCommit().GetSHA(), prs); reason != "" {
+					cmd.SilenceUsage = true
+					return fmt.Errorf("not deleting %s, use --force to delete it anyway", reason)
+				}
+			}
+			if err := ghApi.DeleteBranch(repo, name); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sDeleted branch %s\n", red, name)
+			return nil
+		},
+	}
+	branchDeleteCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the branch belongs to")
+	branchDeleteCmd.Flags().BoolVarP(&force, "force", "f", false, "delete the branch even if it isn't merged")
+	_ = branchDeleteCmd.MarkFlagRequired("repo")
+	return branchDeleteCmd
+}
+
+func init() {
+	branchCmd.AddCommand(newBranchDeleteCmd())
+}
@@ -0,0 +1,219 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// branchProtectionCmd represents the branch protection command
+var branchProtectionCmd = &cobra.Command{
+	Use:   "protection",
+	Short: "View and apply branch protection rules",
+}
+
+// protectionSpec is the content of a protection file, eg
+//
+//	required_status_checks:
+//	  strict: true
+//	  contexts: [build, test]
+//	required_pull_request_reviews:
+//	  required_approving_review_count: 2
+//	  require_code_owner_reviews: true
+//	restrictions:
+//	  users: [octocat]
+//	  teams: [release-managers]
+//
+// A missing section turns that rule off.
+type protectionSpec struct {
+	RequiredStatusChecks *checksSpec       `yaml:"required_status_checks,omitempty"`
+	RequiredReviews      *reviewsSpec      `yaml:"required_pull_request_reviews,omitempty"`
+	EnforceAdmins        bool              `yaml:"enforce_admins"`
+	Restrictions         *restrictionsSpec `yaml:"restrictions,omitempty"`
+}
+
+type checksSpec struct {
+	Strict   bool     `yaml:"strict"`
+	Contexts []string `yaml:"contexts"`
+}
+
+type reviewsSpec struct {
+	Count                   int  `yaml:"required_approving_review_count"`
+	DismissStaleReviews     bool `yaml:"dismiss_stale_reviews"`
+	RequireCodeOwnerReviews bool `yaml:"require_code_owner_reviews"`
+}
+
+type restrictionsSpec struct {
+	Users []string `yaml:"users"`
+	Teams []string `yaml:"teams"`
+}
+
+// protectionSetting is a single rule of a protectionSpec, eg
+// "required_status_checks.strict".
+type protectionSetting struct {
+	key, value string
+}
+
+func sortedList(values []string) string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return "[" + strings.Join(sorted, ", ") + "]"
+}
+
+// settings flattens spec into the rules it turns on, so that two specs can be
+// compared rule by rule.
+func (spec *protectionSpec) settings() []protectionSetting {
+	var settings []protectionSetting
+	if c := spec.RequiredStatusChecks; c != nil {
+		settings = append(settings,
+			protectionSetting{"required_status_checks.strict", strconv.FormatBool(c.Strict)},
+			protectionSetting{"required_status_checks.contexts", sortedList(c.Contexts)})
+	}
+	if r := spec.RequiredReviews; r != nil {
+		settings = append(settings,
+			protectionSetting{"required_pull_request_reviews.required_approving_review_count", strconv.Itoa(r.Count)},
+			protectionSetting{"required_pull_request_reviews.dismiss_stale_reviews", strconv.FormatBool(r.DismissStaleReviews)},
+			protectionSetting{"required_pull_request_reviews.require_code_owner_reviews", strconv.FormatBool(r.RequireCodeOwnerReviews)})
+	}
+	settings = append(settings, protectionSetting{"enforce_admins", strconv.FormatBool(spec.EnforceAdmins)})
+	if r := spec.Restrictions; r != nil {
+		settings = append(settings,
+			protectionSetting{"restrictions.users", sortedList(r.Users)},
+			protectionSetting{"restrictions.teams", sortedList(r.Teams)})
+	}
+	return settings
+}
+
+// diffProtection lists the changes turning current into desired, one per
+// line: "+" for rules turned on, "-" for rules turned off and "~" for rules
+// whose value changes.
+func diffProtection(current, desired *protectionSpec) []string {
+	have := make(map[string]string)
+	for _, s := range current.settings() {
+		have[s.key] = s.value
+	}
+	var diff []string
+	want := make(map[string]bool)
+	for _, s := range desired.settings() {
+		want[s.key] = true
+		value, ok := have[s.key]
+		if !ok {
+			diff = append(diff, fmt.Sprintf("+ %s: %s", s.key, s.value))
+		} else if value != s.value {
+			diff = append(diff, fmt.Sprintf("~ %s: %s -> %s", s.key, value, s.value))
+		}
+	}
+	for _, s := range current.settings() {
+		if !want[s.key] {
+			diff = append(diff, fmt.Sprintf("- %s: %s", s.key, s.value))
+		}
+	}
+	return diff
+}
+
+// protectionFromGitHub converts the protection rules of a branch, which are
+// nil for an unprotected branch, to a protectionSpec.
+func protectionFromGitHub(protection *github.Protection) *protectionSpec {
+	spec := new(protectionSpec)
+	if protection == nil {
+		return spec
+	}
+	if c := protection.RequiredStatusChecks; c != nil {
+		spec.RequiredStatusChecks = &checksSpec{Strict: c.Strict, Contexts: c.Contexts}
+	}
+	if r := protection.RequiredPullRequestReviews; r != nil {
+		spec.RequiredReviews = &reviewsSpec{
+			Count:                   r.RequiredApprovingReviewCount,
+			DismissStaleReviews:     r.DismissStaleReviews,
+			RequireCodeOwnerReviews: r.RequireCodeOwnerReviews,
+		}
+	}
+	if a := protection.EnforceAdmins; a != nil {
+		spec.EnforceAdmins = a.Enabled
+	}
+	if r := protection.Restrictions; r != nil {
+		spec.Restrictions = &restrictionsSpec{Users: []string{}, Teams: []string{}}
+		for _, user := range r.Users {
+			spec.Restrictions.Users = append(spec.Restrictions.Users, user.GetLogin())
+		}
+		for _, team := range r.Teams {
+			spec.Restrictions.Teams = append(spec.Restrictions.Teams, team.GetSlug())
+		}
+	}
+	return spec
+}
+
+// request converts spec to the request replacing the protection of a branch.
+func (spec *protectionSpec) request() *github.ProtectionRequest {
+	req := &github.ProtectionRequest{EnforceAdmins: spec.EnforceAdmins}
+	if c := spec.RequiredStatusChecks; c != nil {
+		// GitHub needs an empty list rather than null.
+		req.RequiredStatusChecks = &github.RequiredStatusChecks{Strict: c.Strict, Contexts: append([]string{}, c.Contexts...)}
+	}
+	if r := spec.RequiredReviews; r != nil {
+		req.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{
+			RequiredApprovingReviewCount: r.Count,
+			DismissStaleReviews:          r.DismissStaleReviews,
+			RequireCodeOwnerReviews:      r.RequireCodeOwnerReviews,
+		}
+	}
+	if r := spec.Restrictions; r != nil {
+		req.Restrictions = &github.BranchRestrictionsRequest{
+			Users: append([]string{}, r.Users...),
+			Teams: append([]string{}, r.Teams...),
+		}
+	}
+	return req
+}
+
+func readProtectionFile(path string) (*protectionSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading protection file: %w", err)
+	}
+	spec := new(protectionSpec)
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	// An empty file turns every rule off.
+	if err := decoder.Decode(spec); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("parsing protection file %s: %w", path, err)
+	}
+	if r := spec.RequiredReviews; r != nil && (r.Count < 0 || r.Count > 6) {
+		return nil, fmt.Errorf("protection file %s: required_approving_review_count has to be between 0 and 6", path)
+	}
+	return spec, nil
+}
+
+func init() {
+	branchCmd.AddCommand(branchProtectionCmd)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newBranchProtectionApplyCmd() *cobra.Command {
+	var repo, file string
+	var dryRun, yes, resetUnsupported bool
+	var branchProtectionApplyCmd = &cobra.Command{
+		Use:   "apply <branch>",
+		Short: "Reconcile the protection rules of a branch with a protection file",
+		Long: `Reconcile the protection rules of a branch with a protection file, printing
+the changes first. Rules which aren't in the file are turned off. Applying
+them needs admin access to the repository.
+
+Some rules, such as required linear history or dismissal restrictions, can't
+be set by a protection file, and applying it turns them off. They are listed
+in the changes and nothing is applied unless --reset-unsupported is given.
+
+The file is YAML in the format printed by branch protection view --yaml:
+
+  required_status_checks:
+    strict: true
+    contexts: [build, test]
+  required_pull_request_reviews:
+    required_approving_review_count: 2
+    dismiss_stale_reviews: false
+    require_code_owner_reviews: true
+  enforce_admins: false
+  restrictions:
+    users: [octocat]
+    teams: [release-managers]`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			branch := args[0]
+			desired, err := readProtectionFile(file)
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			protection, err := ghApi.GetBranchProtection(repo, branch)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			// Applying the rules protects an unprotected branch even when
+			// they turn everything off.
+			diff := diffProtection(protectionFromGitHub(protection), desired)
+			if len(diff) == 0 && protection != nil {
+				fmt.Fprintf(out, "Protection of %s in %s is up to date\n", branch, repo)
+				return nil
+			}
+			var unsupported []string
+			if protection == nil {
+				fmt.Fprintf(out, "+ protect %s\n", branch)
+			} else if unsupported, err = ghApi.UnsupportedProtection(repo, branch); err != nil {
+				return err
+			}
+			for _, line := range diff {
+				fmt.Fprintln(out, line)
+			}
+			for _, rule := range unsupported {
+				fmt.Fprintf(out, "- %s: turned off, protection files can't set it\n", rule)
+			}
+			if dryRun {
+				return nil
+			}
+			if len(unsupported) > 0 && !resetUnsupported {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("not applying, it would turn off %s, use --reset-unsupported to apply anyway", strings.Join(unsupported, ", "))
+			}
+			if !yes {
+				ok, err := confirm(cmd, fmt.Sprintf("Apply these changes to %s in %s?", branch, repo))
+				if err != nil {
+					return err
+				}
+				if !ok {
+					fmt.Fprintln(out, "Nothing changed")
+					return nil
+				}
+			}
+			if _, err := ghApi.UpdateBranchProtection(repo, branch, desired.request()); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "%sUpdated protection of %s\n", green, branch)
+			return nil
+		},
+	}
+	branchProtectionApplyCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the branch belongs to")
+	branchProtectionApplyCmd.Flags().StringVarP(&file, "file", "f", "", "YAML file with the protection rules the branch should have")
+	branchProtectionApplyCmd.Flags().BoolVar(&dryRun, "dry-run", false, "only print the changes which would be made")
+	branchProtectionApplyCmd.Flags().BoolVarP(&yes, "yes", "y", false, "apply the changes without asking for confirmation")
+	branchProtectionApplyCmd.Flags().BoolVar(&resetUnsupported, "reset-unsupported", false, "apply even if it turns off rules protection files can't set")
+	_ = branchProtectionApplyCmd.MarkFlagRequired("repo")
+	_ = branchProtectionApplyCmd.MarkFlagRequired("file")
+	return branchProtectionApplyCmd
+}
+
+func init() {
+	branchProtectionCmd.AddCommand(newBranchProtectionApplyCmd())
+}
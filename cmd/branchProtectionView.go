@@ -0,0 +1,89 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+	"gopkg.in/yaml.v3"
+)
+
+func newBranchProtectionViewCmd() *cobra.Command {
+	var repo string
+	var asYAML bool
+	var branchProtectionViewCmd = &cobra.Command{
+		Use:   "view <branch>",
+		Short: "View the protection rules of a branch",
+		Long: `View the protection rules of a branch. With --yaml they are printed as a
+protection file for branch protection apply. Reading them needs admin access
+to the repository.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			protection, err := ghApi.GetBranchProtection(repo, args[0])
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			spec := protectionFromGitHub(protection)
+			if protection != nil {
+				unsupported, err := ghApi.UnsupportedProtection(repo, args[0])
+				if err != nil {
+					return err
+				}
+				for _, rule := range unsupported {
+					fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s is on, protection files can't set it\n", rule)
+				}
+			}
+			if asYAML {
+				encoder := yaml.NewEncoder(out)
+				encoder.SetIndent(2)
+				if err := encoder.Encode(spec); err != nil {
+					return err
+				}
+				return encoder.Close()
+			}
+			if protection == nil {
+				fmt.Fprintf(out, "%s%s isn't protected%s\n", yellow, args[0], reset)
+				return nil
+			}
+			for _, setting := range spec.settings() {
+				fmt.Fprintf(out, "%s%s: %s%s\n", green, setting.key, reset, setting.value)
+			}
+			return nil
+		},
+	}
+	branchProtectionViewCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the branch belongs to")
+	branchProtectionViewCmd.Flags().BoolVar(&asYAML, "yaml", false, "print the rules as a protection file")
+	_ = branchProtectionViewCmd.MarkFlagRequired("repo")
+	return branchProtectionViewCmd
+}
+
+func init() {
+	branchProtectionCmd.AddCommand(newBranchProtectionViewCmd())
+}
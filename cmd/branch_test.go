@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/github"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDiffProtection(t *testing.T) {
+	current := &protectionSpec{
+		RequiredStatusChecks: &checksSpec{Strict: true, Contexts: []string{"test", "build"}},
+		RequiredReviews:      &reviewsSpec{Count: 1},
+	}
+	desired := &protectionSpec{
+		RequiredStatusChecks: &checksSpec{Strict: true, Contexts: []string{"build", "test", "lint"}},
+		EnforceAdmins:        true,
+		Restrictions:         &restrictionsSpec{Users: []string{"octocat"}},
+	}
+	want := []string{
+		"~ required_status_checks.contexts: [build, test] -> [build, lint, test]",
+		"~ enforce_admins: false -> true",
+		"+ restrictions.users: [octocat]",
+		"+ restrictions.teams: []",
+		"- required_pull_request_reviews.required_approving_review_count: 1",
+		"- required_pull_request_reviews.dismiss_stale_reviews: false",
+		"- required_pull_request_reviews.require_code_owner_reviews: false",
+	}
+	if got := diffProtection(current, desired); !reflect.DeepEqual(got, want) {
+		t.Errorf("diffProtection() = %q, want %q", got, want)
+	}
+	if got := diffProtection(desired, desired); len(got) != 0 {
+		t.Errorf("diffProtection() of equal specs = %q", got)
+	}
+}
+
+func TestReadProtectionFile(t *testing.T) {
+	// A protection which requires PRs but no approvals survives the round
+	// trip through view --yaml.
+	current := protectionFromGitHub(&github.Protection{
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{RequiredApprovingReviewCount: 0},
+	})
+	data, err := yaml.Marshal(current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "protection.yml")
+	if err := os.WriteFile(file, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	desired, err := readProtectionFile(file)
+	if err != nil {
+		t.Fatalf("readProtectionFile() error = %v", err)
+	}
+	if diff := diffProtection(current, desired); len(diff) != 0 {
+		t.Errorf("readProtectionFile() of view --yaml differs: %q", diff)
+	}
+	if err := os.WriteFile(file, []byte("required_pull_request_reviews:\n  required_approving_review_count: 7\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readProtectionFile(file); err == nil {
+		t.Errorf("readProtectionFile() with 7 required approvals didn't fail")
+	}
+}
+
+func TestCheckBranchMerged(t *testing.T) {
+	merged := time.Now()
+	pr := func(number int, state, sha string, mergedAt *time.Time) *github.PullRequest {
+		return &github.PullRequest{
+			Number:   github.Int(number),
+			State:    github.String(state),
+			Head:     &github.PullRequestBranch{SHA: github.String(sha)},
+			MergedAt: mergedAt,
+		}
+	}
+	tests := []struct {
+		prs  []*github.PullRequest
+		want string
+	}{
+		{[]*github.PullRequest{pr(1, "closed", "abc", &merged)}, ""},
+		{[]*github.PullRequest{pr(1, "closed", "abc", &merged), pr(2, "open", "abc", nil)}, "fix is the head of open PR #2"},
+		{[]*github.PullRequest{pr(1, "closed", "old", &merged)}, "fix has commits which weren't in PR #1 when it was merged"},
+		{[]*github.PullRequest{pr(1, "closed", "abc", nil)}, "fix has no merged PR"},
+		{nil, "fix has no merged PR"},
+	}
+	for _, tt := range tests {
+		if got := checkBranchMerged("fix", "abc", tt.prs); got != tt.want {
+			t.Errorf("checkBranchMerged() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestBranchProtectionApplyCmd(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "protection.yml")
+	spec := "required_status_checks:\n  strict: true\n  contexts: [build]\nrequired_pull_request_reviews:\n  required_approving_review_count: 2\n"
+	if err := os.WriteFile(file, []byte(spec), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var sent map[string]interface{}
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/repos/TheAlgorithms/Go/branches/main/protection" {
+			t.Errorf("unexpected request %v", req.URL)
+		}
+		if req.Method == "PUT" {
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"required_status_checks": {"strict": false, "contexts": ["build"]}, "enforce_admins": {"enabled": false}}`)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"branch", "protection", "apply", "main", "-r", "TheAlgorithms/Go", "-f", file, "--yes"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	want := "~ required_status_checks.strict: false -> true\n" +
+		"+ required_pull_request_reviews.required_approving_review_count: 2\n" +
+		"+ required_pull_request_reviews.dismiss_stale_reviews: false\n" +
+		"+ required_pull_request_reviews.require_code_owner_reviews: false\n" +
+		green + "Updated protection of main\n"
+	if buff.String() != want {
+		t.Errorf("branch protection apply printed %q, want %q", buff.String(), want)
+	}
+	reviews, _ := sent["required_pull_request_reviews"].(map[string]interface{})
+	if reviews["required_approving_review_count"] != 2.0 || sent["restrictions"] != nil || sent["enforce_admins"] != false {
+		t.Errorf("branch protection apply sent %v", sent)
+	}
+}
+
+func TestBranchProtectionApplyCmdUnsupported(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "protection.yml")
+	if err := os.WriteFile(file, []byte("enforce_admins: true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	put := false
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		put = put || req.Method == "PUT"
+		return &http.Response{
+			StatusCode: 200,
+			Body: ioutil.NopCloser(bytes.NewBufferString(`{"enforce_admins": {"enabled": false},
+				"required_linear_history": {"enabled": true}, "allow_force_pushes": {"enabled": false}}`)),
+			Header: make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	args := []string{"branch", "protection", "apply", "main", "-r", "TheAlgorithms/Go", "-f", file, "--yes"}
+	rootCmd.SetArgs(args)
+	err := rootCmd.Execute()
+	want := "not applying, it would turn off required_linear_history, use --reset-unsupported to apply anyway"
+	if err == nil || err.Error() != want {
+		t.Errorf("branch protection apply error = %v, want %v", err, want)
+	}
+	if put {
+		t.Errorf("branch protection apply changed the protection")
+	}
+	preview := "~ enforce_admins: false -> true\n- required_linear_history: turned off, protection files can't set it\n"
+	if !strings.HasPrefix(buff.String(), preview) {
+		t.Errorf("branch protection apply printed %q, want %q", buff.String(), preview)
+	}
+
+	buff.Reset()
+	rootCmd.SetArgs(append(args, "--reset-unsupported"))
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !put {
+		t.Errorf("branch protection apply --reset-unsupported didn't change the protection")
+	}
+}
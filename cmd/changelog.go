@@ -0,0 +1,353 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+	"gopkg.in/yaml.v3"
+)
+
+// changelogConcurrency is the number of commits whose PRs are looked up at
+// once.
+const changelogConcurrency = 8
+
+// releaseConfig is the format of .github/release.yml, which GitHub uses for
+// its generated release notes too, eg
+//
+//	changelog:
+//	  exclude:
+//	    labels: [ignore-for-release]
+//	  categories:
+//	    - title: Features
+//	      labels: [enhancement]
+//	    - title: Other Changes
+//	      labels: ["*"]
+type releaseConfig struct {
+	Changelog struct {
+		Exclude    changelogFilter     `yaml:"exclude"`
+		Categories []changelogCategory `yaml:"categories"`
+	} `yaml:"changelog"`
+}
+
+type changelogCategory struct {
+	Title   string          `yaml:"title"`
+	Labels  []string        `yaml:"labels"`
+	Exclude changelogFilter `yaml:"exclude"`
+}
+
+type changelogFilter struct {
+	Labels  []string `yaml:"labels"`
+	Authors []string `yaml:"authors"`
+}
+
+// matches reports whether pr has one of the labels of f, "*" matching any
+// label, or is authored by one of its authors.
+func (f changelogFilter) matches(pr *github.PullRequest) bool {
+	for _, author := range f.Authors {
+		if strings.EqualFold(author, pr.GetUser().GetLogin()) {
+			return true
+		}
+	}
+	return hasAnyLabel(pr, f.Labels)
+}
+
+func hasAnyLabel(pr *github.PullRequest, labels []string) bool {
+	for _, want := range labels {
+		if want == "*" {
+			return true
+		}
+		for _, label := range pr.Labels {
+			if strings.EqualFold(label.GetName(), want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type changelogEntry struct {
+	Number int      `json:"number"`
+	Title  string   `json:"title"`
+	Author string   `json:"author"`
+	URL    string   `json:"url"`
+	Labels []string `json:"labels,omitempty"`
+}
+
+type changelogSection struct {
+	Title   string           `json:"title,omitempty"`
+	Entries []changelogEntry `json:"entries"`
+}
+
+type changelog struct {
+	From            string             `json:"from"`
+	To              string             `json:"to"`
+	Sections        []changelogSection `json:"sections"`
+	NewContributors []changelogEntry   `json:"new_contributors"`
+	CompareURL      string             `json:"compare_url"`
+}
+
+func newChangelogEntry(pr *github.PullRequest) changelogEntry {
+	entry := changelogEntry{
+		Number: pr.GetNumber(),
+		Title:  pr.GetTitle(),
+		Author: pr.GetUser().GetLogin(),
+		URL:    pr.GetHTMLURL(),
+	}
+	for _, label := range pr.Labels {
+		entry.Labels = append(entry.Labels, label.GetName())
+	}
+	return entry
+}
+
+// categorisePRs sorts prs into the categories of config the way GitHub does:
+// each PR goes into the first category with one of its labels, unless that
+// category excludes it. PRs no category takes are listed under "Other
+// Changes", or in a single untitled section when there are no categories.
+func categorisePRs(config *releaseConfig, prs []*github.PullRequest) []changelogSection {
+	categories := config.Changelog.Categories
+	sections := make([]changelogSection, len(categories))
+	for i, category := range categories {
+		sections[i].Title = category.Title
+	}
+	var other changelogSection
+	if len(categories) > 0 {
+		other.Title = "Other Changes"
+	}
+	for _, pr := range prs {
+		if config.Changelog.Exclude.matches(pr) {
+			continue
+		}
+		section := &other
+		for i, category := range categories {
+			if hasAnyLabel(pr, category.Labels) && !category.Exclude.matches(pr) {
+				section = &sections[i]
+				break
+			}
+		}
+		section.Entries = append(section.Entries, newChangelogEntry(pr))
+	}
+	sections = append(sections, other)
+	nonEmpty := sections[:0]
+	for _, section := range sections {
+		if len(section.Entries) > 0 {
+			nonEmpty = append(nonEmpty, section)
+		}
+	}
+	return nonEmpty
+}
+
+// writeChangelog writes log in the Markdown format of GitHub's generated
+// release notes.
+func writeChangelog(w io.Writer, log *changelog) {
+	fmt.Fprintln(w, "## What's Changed")
+	for _, section := range log.Sections {
+		if section.Title != "" {
+			fmt.Fprintf(w, "### %s\n", section.Title)
+		}
+		for _, entry := range section.Entries {
+			fmt.Fprintf(w, "* %s by @%s in %s\n", entry.Title, entry.Author, entry.URL)
+		}
+	}
+	if len(log.NewContributors) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "## New Contributors")
+		for _, entry := range log.NewContributors {
+			fmt.Fprintf(w, "* @%s made their first contribution in %s\n", entry.Author, entry.URL)
+		}
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "**Full Changelog**: %s\n", log.CompareURL)
+}
+
+// readReleaseConfig reads the release notes configuration from path, or from
+// .github/release.yml of repo at ref when path is empty. Without any
+// configuration the PRs aren't categorised.
+func readReleaseConfig(ghApi *api.API, repo, ref, path string) (*releaseConfig, error) {
+	var data string
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading release config: %w", err)
+		}
+		data = string(content)
+	} else {
+		for _, name := range []string{".github/release.yml", ".github/release.yaml"} {
+			content, found, err := ghApi.GetFileContent(repo, name, ref)
+			if err != nil {
+				return nil, err
+			}
+			if found {
+				data, path = content, name
+				break
+			}
+		}
+	}
+	config := new(releaseConfig)
+	if err := yaml.Unmarshal([]byte(data), config); err != nil {
+		return nil, fmt.Errorf("parsing release config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// mergedPRs returns the PRs which were merged by the given commits, oldest
+// first.
+func mergedPRs(ghApi *api.API, repo string, commits []*github.RepositoryCommit) ([]*github.PullRequest, error) {
+	inRange := make(map[string]bool, len(commits))
+	for _, commit := range commits {
+		inRange[commit.GetSHA()] = true
+	}
+	results := make([][]*github.PullRequest, len(commits))
+	errs := make([]error, len(commits))
+	sem := make(chan struct{}, changelogConcurrency)
+	var wg sync.WaitGroup
+	for i, commit := range commits {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, sha string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i], errs[i] = ghApi.ListCommitPRs(repo, sha)
+		}(i, commit.GetSHA())
+	}
+	wg.Wait()
+
+	seen := make(map[int]bool)
+	var prs []*github.PullRequest
+	for i := range commits {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		for _, pr := range results[i] {
+			// A commit is also associated with open PRs containing it
+			// and with PRs merged into other branches.
+			if pr.MergedAt == nil || !inRange[pr.GetMergeCommitSHA()] || seen[pr.GetNumber()] {
+				continue
+			}
+			seen[pr.GetNumber()] = true
+			prs = append(prs, pr)
+		}
+	}
+	sort.SliceStable(prs, func(i, j int) bool {
+		return prs[i].GetMergedAt().Before(prs[j].GetMergedAt())
+	})
+	return prs, nil
+}
+
+// newContributors returns the first PR of every author of prs who had no
+// PR merged into repo before.
+func newContributors(ghApi *api.API, repo string, prs []*github.PullRequest) ([]changelogEntry, error) {
+	var entries []changelogEntry
+	seen := make(map[string]bool)
+	for _, pr := range prs {
+		author := pr.GetUser().GetLogin()
+		if author == "" || seen[author] {
+			continue
+		}
+		seen[author] = true
+		query := fmt.Sprintf("repo:%s is:pr is:merged author:%s merged:<%s", repo, author, pr.GetMergedAt().UTC().Format(time.RFC3339))
+		earlier, _, err := ghApi.SearchIssues(query, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(earlier) == 0 {
+			entries = append(entries, newChangelogEntry(pr))
+		}
+	}
+	return entries, nil
+}
+
+func newChangelogCmd() *cobra.Command {
+	var repo string
+	var from, to, configPath string
+	var asJSON bool
+	var changelogCmd = &cobra.Command{
+		Use:   "changelog",
+		Short: "Generate release notes from the PRs merged between two refs",
+		Long: `Generate release notes from the PRs merged between --from and --to, by default
+the default branch. The PRs are grouped into sections by their labels as
+configured in .github/release.yml, the same file GitHub uses for its generated
+release notes, and the authors of first contributions are credited separately.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			if to == "" {
+				repository, err := ghApi.GetRepo(repo)
+				if err != nil {
+					return err
+				}
+				to = repository.GetDefaultBranch()
+			}
+			config, err := readReleaseConfig(ghApi, repo, to, configPath)
+			if err != nil {
+				return err
+			}
+			commits, err := ghApi.ListCommitsBetween(repo, from, to)
+			if err != nil {
+				return err
+			}
+			prs, err := mergedPRs(ghApi, repo, commits)
+			if err != nil {
+				return err
+			}
+			log := &changelog{
+				From:       from,
+				To:         to,
+				Sections:   categorisePRs(config, prs),
+				CompareURL: fmt.Sprintf("https://github.com/%s/compare/%s...%s", repo, from, to),
+			}
+			log.NewContributors, err = newContributors(ghApi, repo, prs)
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				return printJSON(cmd.OutOrStdout(), log)
+			}
+			writeChangelog(cmd.OutOrStdout(), log)
+			return nil
+		},
+	}
+	changelogCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to generate the release notes of")
+	changelogCmd.Flags().StringVar(&from, "from", "", "tag or SHA of the previous release")
+	changelogCmd.Flags().StringVar(&to, "to", "", "branch, tag or SHA of the new release")
+	changelogCmd.Flags().StringVarP(&configPath, "config", "c", "", "release notes configuration to use instead of the repository's .github/release.yml")
+	changelogCmd.Flags().BoolVar(&asJSON, "json", false, "print the release notes as JSON")
+	_ = changelogCmd.MarkFlagRequired("repo")
+	_ = changelogCmd.MarkFlagRequired("from")
+	return changelogCmd
+}
+
+func init() {
+	rootCmd.AddCommand(newChangelogCmd())
+}
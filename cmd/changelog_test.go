@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+func TestCategorisePRs(t *testing.T) {
+	pr := func(number int, author string, labels ...string) *github.PullRequest {
+		pr := &github.PullRequest{Number: github.Int(number), User: &github.User{Login: github.String(author)}}
+		for _, label := range labels {
+			pr.Labels = append(pr.Labels, &github.Label{Name: github.String(label)})
+		}
+		return pr
+	}
+	config := new(releaseConfig)
+	config.Changelog.Exclude.Labels = []string{"skip-changelog"}
+	config.Changelog.Categories = []changelogCategory{
+		{Title: "Features", Labels: []string{"enhancement"}, Exclude: changelogFilter{Authors: []string{"dependabot"}}},
+		{Title: "Fixes", Labels: []string{"Bug"}},
+	}
+	prs := []*github.PullRequest{
+		pr(1, "octocat", "enhancement", "bug"),
+		pr(2, "octocat", "bug"),
+		pr(3, "octocat", "skip-changelog", "bug"),
+		pr(4, "dependabot", "enhancement"),
+		pr(5, "octocat"),
+	}
+	var got [][]int
+	var titles []string
+	for _, section := range categorisePRs(config, prs) {
+		titles = append(titles, section.Title)
+		var numbers []int
+		for _, entry := range section.Entries {
+			numbers = append(numbers, entry.Number)
+		}
+		got = append(got, numbers)
+	}
+	if want := []string{"Features", "Fixes", "Other Changes"}; !reflect.DeepEqual(titles, want) {
+		t.Errorf("sections = %v, want %v", titles, want)
+	}
+	if want := [][]int{{1}, {2}, {4, 5}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("categorised PRs = %v, want %v", got, want)
+	}
+
+	sections := categorisePRs(new(releaseConfig), prs)
+	if len(sections) != 1 || sections[0].Title != "" || len(sections[0].Entries) != 5 {
+		t.Errorf("categorisePRs() without categories = %v", sections)
+	}
+}
+
+func TestChangelogCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		status, body := 200, `[]`
+		switch req.URL.Path {
+		case "/repos/TheAlgorithms/Go/contents/.github/release.yml":
+			// changelog:
+			//   categories:
+			//     - title: Features
+			//       labels: [enhancement]
+			body = `{"type": "file", "encoding": "base64", "content": "Y2hhbmdlbG9nOgogIGNhdGVnb3JpZXM6CiAgICAtIHRpdGxlOiBGZWF0dXJlcwogICAgICBsYWJlbHM6IFtlbmhhbmNlbWVudF0K"}`
+		case "/repos/TheAlgorithms/Go/compare/v1.0.0...v1.1.0":
+			body = `{"commits": [{"sha": "a"}, {"sha": "b"}, {"sha": "c"}]}`
+		case "/repos/TheAlgorithms/Go/commits/a/pulls":
+			body = `[{"number": 1, "title": "Add heap sort", "merged_at": "2022-03-01T10:00:00Z", "merge_commit_sha": "a",
+				"html_url": "https://github.com/TheAlgorithms/Go/pull/1", "user": {"login": "octocat"}, "labels": [{"name": "enhancement"}]},
+				{"number": 9, "title": "Open PR", "merge_commit_sha": "x", "user": {"login": "octocat"}}]`
+		case "/repos/TheAlgorithms/Go/commits/b/pulls":
+			body = `[{"number": 2, "title": "Fix typo", "merged_at": "2022-03-02T10:00:00Z", "merge_commit_sha": "c",
+				"html_url": "https://github.com/TheAlgorithms/Go/pull/2", "user": {"login": "newbie"}}]`
+		case "/repos/TheAlgorithms/Go/commits/c/pulls":
+			body = `[{"number": 2, "title": "Fix typo", "merged_at": "2022-03-02T10:00:00Z", "merge_commit_sha": "c",
+				"html_url": "https://github.com/TheAlgorithms/Go/pull/2", "user": {"login": "newbie"}}]`
+		case "/search/issues":
+			body = `{"total_count": 0, "items": []}`
+			if strings.Contains(req.URL.Query().Get("q"), "author:octocat") {
+				body = `{"total_count": 1, "items": [{"number": 0}]}`
+			}
+		default:
+			status, body = 404, `{"message": "Not Found"}`
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"changelog", "-r", "TheAlgorithms/Go", "--from", "v1.0.0", "--to", "v1.1.0"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := "## What's Changed\n" +
+		"### Features\n" +
+		"* Add heap sort by @octocat in https://github.com/TheAlgorithms/Go/pull/1\n" +
+		"### Other Changes\n" +
+		"* Fix typo by @newbie in https://github.com/TheAlgorithms/Go/pull/2\n" +
+		"\n" +
+		"## New Contributors\n" +
+		"* @newbie made their first contribution in https://github.com/TheAlgorithms/Go/pull/2\n" +
+		"\n" +
+		"**Full Changelog**: https://github.com/TheAlgorithms/Go/compare/v1.0.0...v1.1.0\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
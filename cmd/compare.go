@@ -0,0 +1,96 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// parseRange splits a range given as "base...head".
+func parseRange(arg string) (base, head string, err error) {
+	parts := strings.Split(arg, "...")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid range %q, expected <base>...<head>", arg)
+	}
+	return parts[0], parts[1], nil
+}
+
+func newCompareCmd() *cobra.Command {
+	var repo string
+	var asJSON bool
+	var compareCmd = &cobra.Command{
+		Use:   "compare <base>...<head>",
+		Short: "Compare two branches, tags or commits",
+		Long: `Show how far head is ahead of and behind base, the commits head adds and a
+summary of the files it changes. Each side may be a branch, tag or SHA, and head
+may be given as owner:branch for a branch of a fork.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			base, head, err := parseRange(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			comparison, err := ghApi.CompareCommits(repo, base, head)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			if asJSON {
+				return printJSON(out, comparison)
+			}
+			if len(comparison.Commits) < comparison.GetTotalCommits() {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: GitHub only lists %d of the %d commits\n", len(comparison.Commits), comparison.GetTotalCommits())
+			}
+			fmt.Fprintf(out, "%sStatus: %s\n", reset, comparison.GetStatus())
+			fmt.Fprintf(out, "%sAhead by: %d\n", green, comparison.GetAheadBy())
+			fmt.Fprintf(out, "%sBehind by: %d\n", red, comparison.GetBehindBy())
+			fmt.Fprintf(out, "%sURL: %s\n", reset, comparison.GetHTMLURL())
+			for i := range comparison.Commits {
+				printCommit(out, &comparison.Commits[i])
+			}
+			files := make([]*github.CommitFile, len(comparison.Files))
+			for i := range comparison.Files {
+				files[i] = &comparison.Files[i]
+			}
+			writeDiffStat(out, files, true)
+			return nil
+		},
+	}
+	compareCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to compare in")
+	compareCmd.Flags().BoolVar(&asJSON, "json", false, "print the comparison as JSON")
+	_ = compareCmd.MarkFlagRequired("repo")
+	return compareCmd
+}
+
+func init() {
+	rootCmd.AddCommand(newCompareCmd())
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	base, head, err := parseRange("v1.0.0...octocat:main")
+	if err != nil || base != "v1.0.0" || head != "octocat:main" {
+		t.Errorf("parseRange() = %q, %q, %v", base, head, err)
+	}
+	for _, arg := range []string{"main", "main..dev", "...dev", "main..."} {
+		if _, _, err := parseRange(arg); err == nil {
+			t.Errorf("parseRange(%q) didn't fail", arg)
+		}
+	}
+}
+
+func TestCompareCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/repos/TheAlgorithms/Go/compare/v1.0.0...main" {
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		body := `{"status": "diverged", "ahead_by": 1, "behind_by": 2, "total_commits": 1,
+			"html_url": "https://github.com/TheAlgorithms/Go/compare/v1.0.0...main",
+			"commits": [{"sha": "0123456789", "commit": {"message": "Add heap sort", "author": {"name": "Mona"}}}],
+			"files": [{"filename": "sort/heap.go", "additions": 3, "deletions": 1, "changes": 4}]}`
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"compare", "v1.0.0...main", "-r", "TheAlgorithms/Go"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := "\x1b[0mStatus: diverged\n\x1b[32mAhead by: 1\n\x1b[31mBehind by: 2\n" +
+		"\x1b[0mURL: https://github.com/TheAlgorithms/Go/compare/v1.0.0...main\n" +
+		"\x1b[33mSHA: 0123456\n\x1b[33mAuthor: Mona\n\x1b[33mSubject: Add heap sort\n\x1b[33mVerified: no\n" +
+		" sort/heap.go | 4 \x1b[32m+\x1b[32m+\x1b[32m+\x1b[31m-\x1b[0m\n" +
+		" 1 files changed, 3 insertions(+), 1 deletions(-)\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// dashboardLimit is the most items shown in a single section of the dashboard.
+const dashboardLimit = 30
+
+type dashboardSection struct {
+	title      string
+	query      string
+	withChecks bool
+	items      []*github.Issue
+	checks     []string
+}
+
+// issueRepo returns the owner/repo an issue returned by the search API
+// belongs to. Search results only link to their repository.
+func issueRepo(issue *github.Issue) string {
+	parts := strings.Split(strings.TrimSuffix(issue.GetRepositoryURL(), "/"), "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	return strings.Join(parts[len(parts)-2:], "/")
+}
+
+func (s *dashboardSection) fetch(ghApi *api.API, scope string) error {
+	items, _, err := ghApi.SearchIssues(s.query+scope, dashboardLimit)
+	if err != nil {
+		return err
+	}
+	s.items = items
+	if !s.withChecks {
+		return nil
+	}
+	s.checks = make([]string, len(items))
+	var wg sync.WaitGroup
+	for i, item := range items {
+		wg.Add(1)
+		go func(i int, item *github.Issue) {
+			defer wg.Done()
+			s.checks[i] = checkState(ghApi, issueRepo(item), item.GetNumber())
+		}(i, item)
+	}
+	wg.Wait()
+	return nil
+}
+
+// checkState summarises the check runs and commit statuses of the head of a
+// PR as "fail" when any failed, "pending" when any hasn't completed, "pass"
+// otherwise and "none" without checks. Failing to retrieve them isn't fatal
+// for the dashboard, the state is unknown instead.
+func checkState(ghApi *api.API, repo string, number int) string {
+	pr, err := ghApi.GetPR(repo, strconv.Itoa(number))
+	if err != nil {
+		return "unknown"
+	}
+	checks, err := fetchChecks(ghApi, repo, pr.GetHead().GetSHA())
+	if err != nil {
+		return "unknown"
+	}
+	failed, pending := countChecks(checks)
+	switch {
+	case len(checks) == 0:
+		return "none"
+	case failed > 0:
+		return "fail"
+	case pending > 0:
+		return "pending"
+	}
+	return "pass"
+}
+
+func checkColour(state string) string {
+	switch state {
+	case "pass":
+		return green
+	case "fail":
+		return red
+	case "pending":
+		return yellow
+	}
+	return reset
+}
+
+func (s *dashboardSection) print(w io.Writer) {
+	fmt.Fprintf(w, "%s%s\n", reset, s.title)
+	if len(s.items) == 0 {
+		fmt.Fprintf(w, "%s  Nothing here\n", reset)
+		return
+	}
+	byRepo := make(map[string][]int)
+	var repos []string
+	for i, item := range s.items {
+		repo := issueRepo(item)
+		if _, ok := byRepo[repo]; !ok {
+			repos = append(repos, repo)
+		}
+		byRepo[repo] = append(byRepo[repo], i)
+	}
+	sort.Strings(repos)
+	for _, repo := range repos {
+		fmt.Fprintf(w, "%s  %s\n", reset, repo)
+		for _, i := range byRepo[repo] {
+			item := s.items[i]
+			if s.withChecks {
+				state := s.checks[i]
+				fmt.Fprintf(w, "%s    #%d %s - checks: %s\n", checkColour(state), item.GetNumber(), item.GetTitle(), state)
+				continue
+			}
+			fmt.Fprintf(w, "%s    #%d %s\n", green, item.GetNumber(), item.GetTitle())
+		}
+	}
+}
+
+// runDashboard prints the PRs and issues which need the attention of the
+// authenticated user, optionally limited to the repositories of org.
+func runDashboard(cmd *cobra.Command, org string) error {
+	scope := ""
+	if org != "" {
+		scope = " org:" + org
+	}
+	since := now().AddDate(0, 0, -14).Format("2006-01-02")
+	sections := []*dashboardSection{
+		{title: "PRs assigned to you", query: "is:pr is:open assignee:@me"},
+		{title: "PRs awaiting your review", query: "is:pr is:open review-requested:@me"},
+		{title: "Your open PRs", query: "is:pr is:open author:@me", withChecks: true},
+		{title: "Issues assigned to you", query: "is:issue is:open assignee:@me"},
+		{title: "Recent mentions", query: "mentions:@me updated:>=" + since},
+	}
+	ghApi := api.NewApi(client)
+	errs := make([]error, len(sections))
+	var wg sync.WaitGroup
+	for i, section := range sections {
+		wg.Add(1)
+		go func(i int, section *dashboardSection) {
+			defer wg.Done()
+			errs[i] = section.fetch(ghApi, scope)
+		}(i, section)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	for _, section := range sections {
+		section.print(cmd.OutOrStdout())
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStatusDashboardCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	oldNow := now
+	defer func() { client, now = oldClient, oldNow }()
+	now = func() time.Time { return time.Date(2022, 5, 15, 0, 0, 0, 0, time.UTC) }
+	client = newTestClient(func(req *http.Request) *http.Response {
+		body := `{"total_count": 0, "items": []}`
+		switch req.URL.Path {
+		case "/search/issues":
+			switch req.URL.Query().Get("q") {
+			case "is:pr is:open author:@me org:TheAlgorithms":
+				body = `{"total_count": 2, "items": [
+	{"number": 2, "title": "Add heap sort", "repository_url": "https://api.github.com/repos/TheAlgorithms/Go"},
+	{"number": 7, "title": "Fix typo", "repository_url": "https://api.github.com/repos/TheAlgorithms/Python"}
+]}`
+			case "mentions:@me updated:>=2022-05-01 org:TheAlgorithms":
+				body = `{"total_count": 1, "items": [
+	{"number": 9, "title": "Question", "repository_url": "https://api.github.com/repos/TheAlgorithms/Go"}
+]}`
+			case "is:pr is:open assignee:@me org:TheAlgorithms",
+				"is:pr is:open review-requested:@me org:TheAlgorithms",
+				"is:issue is:open assignee:@me org:TheAlgorithms":
+			default:
+				t.Errorf("unexpected search query %q", req.URL.Query().Get("q"))
+			}
+		case "/repos/TheAlgorithms/Go/pulls/2":
+			body = `{"number": 2, "head": {"sha": "abc"}}`
+		case "/repos/TheAlgorithms/Go/commits/abc/status":
+			body = `{"state": "success", "total_count": 1, "statuses": [{"context": "ci", "state": "success"}]}`
+		case "/repos/TheAlgorithms/Go/commits/abc/check-runs":
+			body = `{"total_count": 0, "check_runs": []}`
+		case "/repos/TheAlgorithms/Python/pulls/7":
+			body = `{"number": 7, "head": {"sha": "def"}}`
+		case "/repos/TheAlgorithms/Python/commits/def/status":
+			body = `{"state": "pending", "total_count": 0, "statuses": []}`
+		case "/repos/TheAlgorithms/Python/commits/def/check-runs":
+			// GitHub Actions only reports check runs.
+			body = `{"total_count": 2, "check_runs": [
+	{"name": "build", "status": "completed", "conclusion": "success"},
+	{"name": "test", "status": "completed", "conclusion": "failure"}
+]}`
+		default:
+			t.Errorf("unexpected URL %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"status", "--org", "TheAlgorithms"})
+	err := rootCmd.Execute()
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := strings.Join([]string{
+		"\x1b[0mPRs assigned to you",
+		"\x1b[0m  Nothing here",
+		"\x1b[0mPRs awaiting your review",
+		"\x1b[0m  Nothing here",
+		"\x1b[0mYour open PRs",
+		"\x1b[0m  TheAlgorithms/Go",
+		"\x1b[32m    #2 Add heap sort - checks: pass",
+		"\x1b[0m  TheAlgorithms/Python",
+		"\x1b[31m    #7 Fix typo - checks: fail",
+		"\x1b[0mIssues assigned to you",
+		"\x1b[0m  Nothing here",
+		"\x1b[0mRecent mentions",
+		"\x1b[0m  TheAlgorithms/Go",
+		"\x1b[32m    #9 Question",
+		"",
+	}, "\n")
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"sort"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+)
+
+// gistCmd represents the gist command
+var gistCmd = &cobra.Command{
+	Use:   "gist",
+	Short: "Work with gists",
+	Long:  `Create, list, view, edit and clone the gists of the authenticated user.`,
+}
+
+// gistFiles returns the files of gist sorted by name.
+func gistFiles(gist *github.Gist) []github.GistFile {
+	files := make([]github.GistFile, 0, len(gist.Files))
+	for _, file := range gist.Files {
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].GetFilename() < files[j].GetFilename()
+	})
+	return files
+}
+
+func init() {
+	rootCmd.AddCommand(gistCmd)
+}
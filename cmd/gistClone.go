@@ -0,0 +1,54 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newGistCloneCmd() *cobra.Command {
+	var gistCloneCmd = &cobra.Command{
+		Use:   "clone <id> [<dir>]",
+		Short: "Clone a gist",
+		Long:  `Clone the git repository of a gist into dir, by default a directory named after the gist ID.`,
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ghApi := api.NewApi(client)
+			gist, err := ghApi.GetGist(args[0])
+			if err != nil {
+				return err
+			}
+			dir := gist.GetID()
+			if len(args) == 2 {
+				dir = args[1]
+			}
+			return cloneRepo(cmd, gist.GetGitPullURL(), dir, "")
+		},
+	}
+	return gistCloneCmd
+}
+
+func init() {
+	gistCmd.AddCommand(newGistCloneCmd())
+}
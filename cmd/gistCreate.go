@@ -0,0 +1,89 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newGistCreateCmd() *cobra.Command {
+	var description, filename string
+	var public bool
+	var gistCreateCmd = &cobra.Command{
+		Use:   "create [<files>... | -]",
+		Short: "Create a gist from files or standard input",
+		Long: `Create a gist of the given files, or of standard input when no file or - is
+given. Gists are secret unless --public is given.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				args = []string{"-"}
+			}
+			gist := &github.Gist{
+				Description: &description,
+				Public:      &public,
+				Files:       make(map[github.GistFilename]github.GistFile),
+			}
+			for _, path := range args {
+				var content []byte
+				var err error
+				name := filepath.Base(path)
+				if path == "-" {
+					content, err = io.ReadAll(cmd.InOrStdin())
+					name = filename
+				} else {
+					content, err = os.ReadFile(path)
+				}
+				if err != nil {
+					return err
+				}
+				if len(content) == 0 {
+					return fmt.Errorf("%s is empty, gists can't have empty files", name)
+				}
+				gist.Files[github.GistFilename(name)] = github.GistFile{Content: github.String(string(content))}
+			}
+			ghApi := api.NewApi(client)
+			created, err := ghApi.CreateGist(gist)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sCreated gist %s\n", green, created.GetID())
+			fmt.Fprintf(cmd.OutOrStdout(), "%sURL: %s\n", green, created.GetHTMLURL())
+			return nil
+		},
+	}
+	gistCreateCmd.Flags().StringVarP(&description, "desc", "d", "", "description of the gist")
+	gistCreateCmd.Flags().StringVarP(&filename, "filename", "f", "gistfile1.txt", "name of the file read from standard input")
+	gistCreateCmd.Flags().BoolVarP(&public, "public", "p", false, "list the gist publicly")
+	return gistCreateCmd
+}
+
+func init() {
+	gistCmd.AddCommand(newGistCreateCmd())
+}
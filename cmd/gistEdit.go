@@ -0,0 +1,214 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+const gistEditHelp = `# Edit the gist below and save to apply the changes. These lines are ignored.
+# Each file starts with a "==> name <==" line:
+#   delete a file's section to delete the file,
+#   change its header to "==> old -> new <==" to rename it,
+#   add a section starting with "==> + name <==" to add a file.
+`
+
+var (
+	gistHeaderRE    = regexp.MustCompile(`^==> (.+?)(?: -> (.+))? <==$`)
+	gistNewHeaderRE = regexp.MustCompile(`^==> \+ (.+) <==$`)
+)
+
+// gistSection is a file of a gist as edited by the user. From is the name in
+// the section header and To the new name, which is the same unless renamed.
+// New is set for files added by the user.
+type gistSection struct {
+	From, To string
+	Content  string
+	New      bool
+}
+
+// formatGist renders gist as the buffer edited by gist edit.
+func formatGist(gist *github.Gist) string {
+	var b strings.Builder
+	b.WriteString(gistEditHelp)
+	fmt.Fprintf(&b, "description: %s\n", gist.GetDescription())
+	for _, file := range gistFiles(gist) {
+		fmt.Fprintf(&b, "==> %s <==\n%s", file.GetFilename(), file.GetContent())
+		if !strings.HasSuffix(file.GetContent(), "\n") {
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// parseGist parses a buffer in the format of formatGist. Only headers naming a
+// file of gist, or adding a new one, start a section, so that files can contain
+// lines which look like headers.
+func parseGist(gist *github.Gist, text string) (string, []gistSection, error) {
+	lines := strings.SplitAfter(text, "\n")
+	for len(lines) > 0 && strings.HasPrefix(lines[0], "#") {
+		lines = lines[1:]
+	}
+	if len(lines) == 0 || !strings.HasPrefix(lines[0], "description:") {
+		return "", nil, fmt.Errorf("expected a description: line before the files")
+	}
+	description := strings.TrimSpace(strings.TrimPrefix(lines[0], "description:"))
+	var sections []gistSection
+	for _, line := range lines[1:] {
+		header := strings.TrimRight(line, "\r\n")
+		if m := gistNewHeaderRE.FindStringSubmatch(header); m != nil {
+			sections = append(sections, gistSection{From: m[1], To: m[1], New: true})
+			continue
+		}
+		m := gistHeaderRE.FindStringSubmatch(header)
+		if m != nil {
+			if _, ok := gist.Files[github.GistFilename(m[1])]; ok {
+				to := m[2]
+				if to == "" {
+					to = m[1]
+				}
+				sections = append(sections, gistSection{From: m[1], To: to})
+				continue
+			}
+		}
+		if len(sections) == 0 {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			if m != nil {
+				return "", nil, fmt.Errorf("the gist has no file %s, start new files with a ==> + name <== line", m[1])
+			}
+			return "", nil, fmt.Errorf("expected a ==> name <== line before %q", strings.TrimSpace(line))
+		}
+		sections[len(sections)-1].Content += line
+	}
+	return description, sections, nil
+}
+
+// gistEdits works out the edits turning the files of gist into sections. At
+// least one file has to be left, as gists can't be empty.
+func gistEdits(gist *github.Gist, sections []gistSection) (map[string]*api.GistFileEdit, error) {
+	edits := make(map[string]*api.GistFileEdit)
+	seen := make(map[string]bool)
+	kept := 0
+	for _, section := range sections {
+		if seen[section.From] {
+			return nil, fmt.Errorf("file %s appears more than once", section.From)
+		}
+		seen[section.From] = true
+		file, ok := gist.Files[github.GistFilename(section.From)]
+		if section.New {
+			if ok {
+				return nil, fmt.Errorf("can't add %s, the gist already has a file called that", section.From)
+			}
+			if strings.TrimSpace(section.Content) == "" {
+				return nil, fmt.Errorf("new file %s is empty, gists can't have empty files", section.From)
+			}
+			edits[section.From] = &api.GistFileEdit{Content: section.Content}
+			kept++
+			continue
+		}
+		if !ok {
+			return nil, fmt.Errorf("can't edit %s, the gist has no such file", section.From)
+		}
+		if strings.TrimSpace(section.Content) == "" {
+			edits[section.From] = nil
+			continue
+		}
+		kept++
+		// formatGist adds a final newline to files without one.
+		changed := section.Content != file.GetContent() && section.Content != file.GetContent()+"\n"
+		if section.To != section.From || changed {
+			edit := new(api.GistFileEdit)
+			if section.To != section.From {
+				edit.Filename = section.To
+			}
+			if changed {
+				edit.Content = section.Content
+			}
+			edits[section.From] = edit
+		}
+	}
+	for name := range gist.Files {
+		if !seen[string(name)] {
+			edits[string(name)] = nil
+		}
+	}
+	if kept == 0 {
+		return nil, fmt.Errorf("refusing to delete every file, delete the gist instead")
+	}
+	return edits, nil
+}
+
+func newGistEditCmd() *cobra.Command {
+	var gistEditCmd = &cobra.Command{
+		Use:   "edit <id>",
+		Short: "Edit a gist in your editor",
+		Long: `Edit the description and files of a gist in $VISUAL or $EDITOR. Files are
+added, removed and renamed by editing their sections in the buffer.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ghApi := api.NewApi(client)
+			gist, err := ghApi.GetGist(args[0])
+			if err != nil {
+				return err
+			}
+			text, err := editText("gist-"+gist.GetID()+".txt", formatGist(gist))
+			if err != nil {
+				return err
+			}
+			description, sections, err := parseGist(gist, text)
+			if err != nil {
+				return err
+			}
+			edits, err := gistEdits(gist, sections)
+			if err != nil {
+				return err
+			}
+			var newDescription *string
+			if description != gist.GetDescription() {
+				newDescription = &description
+			}
+			if len(edits) == 0 && newDescription == nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "%sNo changes to gist %s\n", yellow, gist.GetID())
+				return nil
+			}
+			if _, err := ghApi.EditGist(gist.GetID(), newDescription, edits); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sEdited gist %s\n", green, gist.GetID())
+			return nil
+		},
+	}
+	return gistEditCmd
+}
+
+func init() {
+	gistCmd.AddCommand(newGistEditCmd())
+}
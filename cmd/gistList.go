@@ -0,0 +1,65 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newGistListCmd() *cobra.Command {
+	var asJSON bool
+	var gistListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List your gists",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ghApi := api.NewApi(client)
+			gists, err := ghApi.ListGists()
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				return printJSON(cmd.OutOrStdout(), gists)
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			for _, gist := range gists {
+				visibility := "secret"
+				if gist.GetPublic() {
+					visibility = "public"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%d files\t%s\t%s\n", gist.GetID(), gist.GetDescription(), len(gist.Files),
+					visibility, gist.GetUpdatedAt().Format("2006-01-02"))
+			}
+			return w.Flush()
+		},
+	}
+	gistListCmd.Flags().BoolVar(&asJSON, "json", false, "print the gists as JSON")
+	return gistListCmd
+}
+
+func init() {
+	gistCmd.AddCommand(newGistListCmd())
+}
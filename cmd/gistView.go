@@ -0,0 +1,71 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newGistViewCmd() *cobra.Command {
+	var file string
+	var gistViewCmd = &cobra.Command{
+		Use:   "view <id>",
+		Short: "View the files of a gist",
+		Long: `View the description and files of a gist. With --file only the content of
+that file is printed, as is, so it can be piped or redirected.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ghApi := api.NewApi(client)
+			gist, err := ghApi.GetGist(args[0])
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			if file != "" {
+				for _, f := range gistFiles(gist) {
+					if f.GetFilename() == file {
+						fmt.Fprint(out, f.GetContent())
+						return nil
+					}
+				}
+				return fmt.Errorf("gist %s has no file %q", gist.GetID(), file)
+			}
+			if gist.GetDescription() != "" {
+				fmt.Fprintf(out, "%s%s%s\n\n", green, gist.GetDescription(), reset)
+			}
+			for _, f := range gistFiles(gist) {
+				fmt.Fprintf(out, "%s%s%s\n\n%s\n", yellow, f.GetFilename(), reset, f.GetContent())
+			}
+			return nil
+		},
+	}
+	gistViewCmd.Flags().StringVarP(&file, "file", "f", "", "only print the content of this file")
+	return gistViewCmd
+}
+
+func init() {
+	gistCmd.AddCommand(newGistViewCmd())
+}
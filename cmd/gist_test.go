@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/github"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+const testGist = `{
+	"id": "aa5a315d",
+	"description": "snippets",
+	"files": {
+		"hello.sh": {"filename": "hello.sh", "content": "echo hello\n"},
+		"notes.txt": {"filename": "notes.txt", "content": "no newline"}
+	}
+}`
+
+func TestGistEdits(t *testing.T) {
+	gist := new(github.Gist)
+	if err := json.Unmarshal([]byte(testGist), gist); err != nil {
+		t.Fatal(err)
+	}
+	description, sections, err := parseGist(gist, formatGist(gist))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if description != "snippets" || len(sections) != 2 {
+		t.Fatalf("parseGist(formatGist()) = %q, %v", description, sections)
+	}
+	edits, err := gistEdits(gist, sections)
+	if err != nil || len(edits) != 0 {
+		t.Errorf("gistEdits() of an unchanged gist = %v, %v", edits, err)
+	}
+
+	text := "# comment\ndescription: more snippets\n==> hello.sh -> hi.sh <==\necho hi\n==> + new.py <==\nprint(1)\n"
+	description, sections, err = parseGist(gist, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if description != "more snippets" {
+		t.Errorf("parseGist() description = %q", description)
+	}
+	edits, err = gistEdits(gist, sections)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]*api.GistFileEdit{
+		"hello.sh":  {Filename: "hi.sh", Content: "echo hi\n"},
+		"new.py":    {Content: "print(1)\n"},
+		"notes.txt": nil,
+	}
+	if !reflect.DeepEqual(edits, want) {
+		t.Errorf("gistEdits() = %v, want %v", edits, want)
+	}
+
+	// Lines like the output of head or tail only start a file when they name
+	// one of the gist.
+	text = "description: snippets\n==> hello.sh <==\necho hello\n==> log.txt <==\n==> notes.txt <==\nno newline\n"
+	_, sections, err = parseGist(gist, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sections) != 2 || sections[0].Content != "echo hello\n==> log.txt <==\n" {
+		t.Errorf("parseGist() with a header-like line = %v", sections)
+	}
+
+	// Emptying every file deletes them all, just like removing their sections.
+	for _, text := range []string{"description: x\n", "description: x\n==> hello.sh <==\n==> notes.txt <==\n\n"} {
+		_, sections, err = parseGist(gist, text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := gistEdits(gist, sections); err == nil {
+			t.Errorf("gistEdits() deleting every file of %q didn't fail", text)
+		}
+	}
+
+	_, sections, _ = parseGist(gist, "description: x\n==> + hello.sh <==\nx\n==> notes.txt <==\nx\n")
+	if _, err := gistEdits(gist, sections); err == nil {
+		t.Errorf("gistEdits() adding an existing file didn't fail")
+	}
+	if _, _, err := parseGist(gist, "description: x\n==> missing.txt -> other.txt <==\nx\n"); err == nil {
+		t.Errorf("parseGist() renaming a missing file didn't fail")
+	}
+	if _, _, err := parseGist(gist, "description: x\nstray\n==> hello.sh <==\n"); err == nil {
+		t.Errorf("parseGist() with content before a header didn't fail")
+	}
+}
+
+func TestGistEditCmd(t *testing.T) {
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", `sed -i -e 's/echo hello/echo bye/'`)
+	var body string
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if req.Method == "PATCH" {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(testGist)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"gist", "edit", "aa5a315d"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"files":{"hello.sh":{"content":"echo bye\n"}}}`; strings.TrimSpace(body) != want {
+		t.Errorf("gist edit sent %s, want %s", body, want)
+	}
+	if got := buff.String(); got != green+"Edited gist aa5a315d\n" {
+		t.Errorf("gist edit printed %q", got)
+	}
+}
+
+func TestGistCreateCmd(t *testing.T) {
+	var gist github.Gist
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if err := json.NewDecoder(req.Body).Decode(&gist); err != nil {
+			t.Fatal(err)
+		}
+		return &http.Response{
+			StatusCode: 201,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"id": "aa5a315d", "html_url": "https://gist.github.com/aa5a315d"}`)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetIn(strings.NewReader("select 1;\n"))
+	defer rootCmd.SetIn(nil)
+	rootCmd.SetArgs([]string{"gist", "create", "--filename", "query.sql", "-d", "a query", "-"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	file := gist.Files["query.sql"]
+	if file.GetContent() != "select 1;\n" || gist.GetDescription() != "a query" || gist.GetPublic() {
+		t.Errorf("gist create sent %+v", gist)
+	}
+	want := green + "Created gist aa5a315d\n" + green + "URL: https://gist.github.com/aa5a315d\n"
+	if buff.String() != want {
+		t.Errorf("gist create printed %q, want %q", buff.String(), want)
+	}
+}
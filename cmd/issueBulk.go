@@ -0,0 +1,221 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// bulkActions are the changes issue bulk makes to every matching issue.
+type bulkActions struct {
+	addLabels    []string
+	removeLabels []string
+	assignees    []string
+	comment      string
+	close        bool
+	milestone    *int
+}
+
+func (b bulkActions) empty() bool {
+	return len(b.addLabels) == 0 && len(b.removeLabels) == 0 && len(b.assignees) == 0 &&
+		b.comment == "" && !b.close && b.milestone == nil
+}
+
+func (b bulkActions) describe(milestone string) []string {
+	var lines []string
+	if b.comment != "" {
+		lines = append(lines, "comment")
+	}
+	if len(b.addLabels) > 0 {
+		lines = append(lines, "add labels "+strings.Join(b.addLabels, ", "))
+	}
+	if len(b.removeLabels) > 0 {
+		lines = append(lines, "remove labels "+strings.Join(b.removeLabels, ", "))
+	}
+	if len(b.assignees) > 0 {
+		lines = append(lines, "assign "+strings.Join(b.assignees, ", "))
+	}
+	if b.milestone != nil {
+		lines = append(lines, "set milestone "+milestone)
+	}
+	if b.close {
+		lines = append(lines, "close")
+	}
+	return lines
+}
+
+// apply makes every change to a single issue, stopping at the first failure.
+// The comment is posted before the issue is closed so it appears above the
+// close event.
+func (b bulkActions) apply(ghApi *api.API, repo string, number int) error {
+	if b.comment != "" {
+		if _, err := ghApi.CreateComment(repo, number, b.comment); err != nil {
+			return err
+		}
+	}
+	if len(b.addLabels) > 0 {
+		if err := ghApi.AddLabels(repo, number, b.addLabels); err != nil {
+			return err
+		}
+	}
+	for _, label := range b.removeLabels {
+		if err := ghApi.RemoveLabel(repo, number, label); err != nil {
+			return err
+		}
+	}
+	if len(b.assignees) > 0 {
+		if err := ghApi.AddAssignees(repo, number, b.assignees); err != nil {
+			return err
+		}
+	}
+	if b.milestone != nil || b.close {
+		edit := &github.IssueRequest{Milestone: b.milestone}
+		if b.close {
+			edit.State = github.String("closed")
+		}
+		if _, err := ghApi.EditIssue(repo, number, edit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newIssueBulkCmd() *cobra.Command {
+	var repo string
+	var query string
+	var milestone string
+	var yes bool
+	var concurrency int
+	var actions bulkActions
+	var issueBulkCmd = &cobra.Command{
+		Use:   "bulk",
+		Short: "Change every issue matching a query",
+		Long: `Change every issue matching a query.
+
+The query uses the GitHub search syntax and is limited to the issues of the
+stated repository, eg to close stale issues which haven't been updated since
+the start of the year:
+
+  ghcli issue bulk -r owner/repo -q 'label:stale updated:<2022-01-01' --close
+
+The matching issues are listed and you are asked for confirmation before
+anything is changed, unless --yes is given.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			if concurrency < 1 {
+				return fmt.Errorf("concurrency must be at least 1")
+			}
+			ghApi := api.NewApi(client)
+			if milestone != "" {
+				m, err := ghApi.FindMilestone(repo, milestone)
+				if err != nil {
+					return err
+				}
+				actions.milestone = m.Number
+			}
+			if actions.empty() {
+				return fmt.Errorf("nothing to do - specify at least one of --add-label, --remove-label, --assign, --milestone, --comment or --close")
+			}
+			issues, incomplete, err := ghApi.SearchIssues(fmt.Sprintf("repo:%s is:issue %s", repo, query), 0)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			if incomplete {
+				fmt.Fprintln(cmd.ErrOrStderr(), "Warning: GitHub timed out before finding every match, some issues may be missing")
+			}
+			if len(issues) == 0 {
+				fmt.Fprintln(out, "No issues match the query")
+				return nil
+			}
+			fmt.Fprintf(out, "%d issues match the query:\n", len(issues))
+			for _, issue := range issues {
+				fmt.Fprintf(out, "  #%d %s\n", issue.GetNumber(), issue.GetTitle())
+			}
+			fmt.Fprintf(out, "Every issue will be changed to: %s\n", strings.Join(actions.describe(milestone), "; "))
+			if !yes {
+				ok, err := confirm(cmd, "Continue?")
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return fmt.Errorf("aborted")
+				}
+			}
+
+			errs := make([]error, len(issues))
+			sem := make(chan struct{}, concurrency)
+			var wg sync.WaitGroup
+			for i, issue := range issues {
+				wg.Add(1)
+				sem <- struct{}{}
+				go func(i, number int) {
+					defer wg.Done()
+					defer func() { <-sem }()
+					errs[i] = actions.apply(ghApi, repo, number)
+				}(i, issue.GetNumber())
+			}
+			wg.Wait()
+
+			failed := 0
+			for i, issue := range issues {
+				if errs[i] != nil {
+					failed++
+					fmt.Fprintf(out, "%sFailed #%d %s: %v\n", red, issue.GetNumber(), issue.GetTitle(), errs[i])
+					continue
+				}
+				fmt.Fprintf(out, "%sUpdated #%d %s\n", green, issue.GetNumber(), issue.GetTitle())
+			}
+			fmt.Fprintf(out, "%d updated, %d failed\n", len(issues)-failed, failed)
+			if failed > 0 {
+				return fmt.Errorf("%d of %d issues could not be updated", failed, len(issues))
+			}
+			return nil
+		},
+	}
+	issueBulkCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the issues belong to")
+	issueBulkCmd.Flags().StringVarP(&query, "query", "q", "", "GitHub search filters selecting the issues, eg 'label:stale state:open'")
+	issueBulkCmd.Flags().StringSliceVar(&actions.addLabels, "add-label", nil, "labels to add")
+	issueBulkCmd.Flags().StringSliceVar(&actions.removeLabels, "remove-label", nil, "labels to remove")
+	issueBulkCmd.Flags().StringSliceVar(&actions.assignees, "assign", nil, "users to assign")
+	issueBulkCmd.Flags().StringVar(&milestone, "milestone", "", "title of the milestone to move the issues to")
+	issueBulkCmd.Flags().StringVar(&actions.comment, "comment", "", "comment to post on every issue")
+	issueBulkCmd.Flags().BoolVar(&actions.close, "close", false, "close the issues")
+	issueBulkCmd.Flags().BoolVarP(&yes, "yes", "y", false, "don't ask for confirmation")
+	issueBulkCmd.Flags().IntVar(&concurrency, "concurrency", 4, "maximum number of issues to update at once")
+	_ = issueBulkCmd.MarkFlagRequired("repo")
+	_ = issueBulkCmd.MarkFlagRequired("query")
+	return issueBulkCmd
+}
+
+func init() {
+	issueCmd.AddCommand(newIssueBulkCmd())
+}
@@ -26,15 +26,7 @@ func newIssueStatusCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			statusColour := green
-			if *issue.State == "closed" {
-				statusColour = red
-			}
-			fmt.Fprintf(cmd.OutOrStdout(), "%sStatus: %s\n", statusColour, *issue.State)
-			fmt.Fprintf(cmd.OutOrStdout(), "%sTitle: %s\n", statusColour, *issue.Title)
-			fmt.Fprintf(cmd.OutOrStdout(), "%sURL: %s\n", statusColour, *issue.HTMLURL)
-			fmt.Fprintf(cmd.OutOrStdout(), "%sNumber: %d\n", statusColour, *issue.Number)
-			fmt.Fprintf(cmd.OutOrStdout(), "%sBody: %s\n", statusColour, *issue.Body)
+			printIssue(cmd.OutOrStdout(), issue)
 			return nil
 		},
 	}
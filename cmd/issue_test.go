@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestIssueBulkCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	var mu sync.Mutex
+	var edits []string
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path == "/search/issues" {
+			if got := req.URL.Query().Get("q"); got != "repo:TheAlgorithms/Go is:issue label:stale" {
+				t.Errorf("search query = %q", got)
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body: ioutil.NopCloser(bytes.NewBufferString(`{"total_count": 2, "items": [
+	{"number": 1, "title": "Old issue"},
+	{"number": 2, "title": "Locked issue"}
+]}`)),
+				Header: make(http.Header),
+			}
+		}
+		mu.Lock()
+		edits = append(edits, req.Method+" "+req.URL.Path)
+		mu.Unlock()
+		status := 200
+		if req.URL.Path == "/repos/TheAlgorithms/Go/issues/2" {
+			status = 403
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"issue", "bulk", "-r", "TheAlgorithms/Go", "-q", "label:stale", "--close", "--yes"})
+	err := rootCmd.Execute()
+	if err == nil || err.Error() != "1 of 2 issues could not be updated" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if len(edits) != 2 {
+		t.Errorf("got requests %v, want one edit per issue", edits)
+	}
+	got := buff.String()
+	for _, want := range []string{
+		"2 issues match the query:\n  #1 Old issue\n  #2 Locked issue\n",
+		"Every issue will be changed to: close\n",
+		"\x1b[32mUpdated #1 Old issue\n",
+		"\x1b[31mFailed #2 Locked issue: EditIssue: error editing issue #2:",
+		"1 updated, 1 failed\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output %q doesn't contain %q", got, want)
+		}
+	}
+}
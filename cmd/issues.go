@@ -33,6 +33,7 @@ import (
 // issuesCmd represents the issues command
 func newListIssuesCmd() *cobra.Command {
 	var repo string
+	var asJSON bool
 	var issuesCmd = &cobra.Command{
 		Use:   "issues",
 		Short: "List all issues for the stated repository",
@@ -46,21 +47,17 @@ func newListIssuesCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if asJSON {
+				return printJSON(cmd.OutOrStdout(), issues)
+			}
 			for _, issue := range issues {
-				statusColour := green
-				if *issue.State == "closed" {
-					statusColour = red
-				}
-				fmt.Fprintf(cmd.OutOrStdout(), "%sStatus: %s\n", statusColour, *issue.State)
-				fmt.Fprintf(cmd.OutOrStdout(), "%sTitle: %s\n", statusColour, *issue.Title)
-				fmt.Fprintf(cmd.OutOrStdout(), "%sURL: %s\n", statusColour, *issue.HTMLURL)
-				fmt.Fprintf(cmd.OutOrStdout(), "%sNumber: %d\n", statusColour, *issue.Number)
-				fmt.Fprintf(cmd.OutOrStdout(), "%sBody: %s\n", statusColour, *issue.Body)
+				printIssue(cmd.OutOrStdout(), issue)
 			}
 			return nil
 		},
 	}
 	issuesCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to retrieve issues from")
+	issuesCmd.Flags().BoolVar(&asJSON, "json", false, "print the issues as JSON")
 	_ = issuesCmd.MarkFlagRequired("repo")
 	return issuesCmd
 }
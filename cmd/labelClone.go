@@ -0,0 +1,84 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newLabelCloneCmd() *cobra.Command {
+	var repo string
+	var from string
+	var prune bool
+	var dryRun bool
+	var labelCloneCmd = &cobra.Command{
+		Use:   "clone",
+		Short: "Copy the labels of another repository",
+		Long: `Copy the labels of another repository into the stated repository.
+
+Missing labels are created and existing labels get the colour and description
+of the source repository. Labels which the source repository doesn't have are
+only deleted when --delete is given.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			if from == "" {
+				return fmt.Errorf("source repo is required")
+			}
+			ghApi := api.NewApi(client)
+			desired, err := ghApi.ListLabels(from)
+			if err != nil {
+				return err
+			}
+			// Labels without a description come back with a null one, which
+			// would keep the description of the label being updated.
+			for _, label := range desired {
+				if label.Description == nil {
+					label.Description = github.String("")
+				}
+			}
+			current, err := ghApi.ListLabels(repo)
+			if err != nil {
+				return err
+			}
+			changes := planLabelChanges(current, desired, prune)
+			return applyLabelChanges(cmd.OutOrStdout(), ghApi, repo, changes, dryRun)
+		},
+	}
+	labelCloneCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to copy labels into")
+	labelCloneCmd.Flags().StringVar(&from, "from", "", "repository to copy labels from, eg 'owner/repo'")
+	labelCloneCmd.Flags().BoolVar(&prune, "delete", false, "delete labels which the source repository doesn't have")
+	labelCloneCmd.Flags().BoolVar(&dryRun, "dry-run", false, "only print the changes which would be made")
+	_ = labelCloneCmd.MarkFlagRequired("repo")
+	_ = labelCloneCmd.MarkFlagRequired("from")
+	return labelCloneCmd
+}
+
+func init() {
+	labelCmd.AddCommand(newLabelCloneCmd())
+}
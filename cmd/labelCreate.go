@@ -0,0 +1,69 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newLabelCreateCmd() *cobra.Command {
+	var repo string
+	var color string
+	var description string
+	var labelCreateCmd = &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a label in the stated repository",
+		Long:  `Create a label in the stated repository.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			label := &github.Label{
+				Name:        github.String(args[0]),
+				Color:       github.String(normaliseColor(color)),
+				Description: github.String(description),
+			}
+			ghApi := api.NewApi(client)
+			created, err := ghApi.CreateLabel(repo, label)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Created label %s\n", created.GetName())
+			return nil
+		},
+	}
+	labelCreateCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to create the label in")
+	labelCreateCmd.Flags().StringVarP(&color, "color", "c", "ededed", "colour of the label as a hex code")
+	labelCreateCmd.Flags().StringVarP(&description, "description", "d", "", "description of the label")
+	_ = labelCreateCmd.MarkFlagRequired("repo")
+	return labelCreateCmd
+}
+
+func init() {
+	labelCmd.AddCommand(newLabelCreateCmd())
+}
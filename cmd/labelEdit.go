@@ -0,0 +1,79 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newLabelEditCmd() *cobra.Command {
+	var repo string
+	var name string
+	var color string
+	var description string
+	var labelEditCmd = &cobra.Command{
+		Use:   "edit <name>",
+		Short: "Edit a label in the stated repository",
+		Long:  `Edit the name, colour or description of a label in the stated repository.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			label := &github.Label{}
+			if cmd.Flags().Changed("name") {
+				label.Name = github.String(name)
+			}
+			if cmd.Flags().Changed("color") {
+				label.Color = github.String(normaliseColor(color))
+			}
+			if cmd.Flags().Changed("description") {
+				label.Description = github.String(description)
+			}
+			if label.Name == nil && label.Color == nil && label.Description == nil {
+				return fmt.Errorf("nothing to edit - specify at least one of --name, --color or --description")
+			}
+			ghApi := api.NewApi(client)
+			edited, err := ghApi.EditLabel(repo, args[0], label)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Edited label %s\n", edited.GetName())
+			return nil
+		},
+	}
+	labelEditCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the label belongs to")
+	labelEditCmd.Flags().StringVarP(&name, "name", "n", "", "new name of the label")
+	labelEditCmd.Flags().StringVarP(&color, "color", "c", "", "new colour of the label as a hex code")
+	labelEditCmd.Flags().StringVarP(&description, "description", "d", "", "new description of the label")
+	_ = labelEditCmd.MarkFlagRequired("repo")
+	return labelEditCmd
+}
+
+func init() {
+	labelCmd.AddCommand(newLabelEditCmd())
+}
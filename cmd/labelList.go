@@ -0,0 +1,62 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newLabelListCmd() *cobra.Command {
+	var repo string
+	var labelListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List all labels of the stated repository",
+		Long:  `List all labels of the stated repository.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			labels, err := ghApi.ListLabels(repo)
+			if err != nil {
+				return err
+			}
+			for _, label := range labels {
+				fmt.Fprintf(cmd.OutOrStdout(), "Name: %s\n", label.GetName())
+				fmt.Fprintf(cmd.OutOrStdout(), "Color: %s\n", label.GetColor())
+				fmt.Fprintf(cmd.OutOrStdout(), "Description: %s\n", label.GetDescription())
+			}
+			return nil
+		},
+	}
+	labelListCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to retrieve labels from")
+	_ = labelListCmd.MarkFlagRequired("repo")
+	return labelListCmd
+}
+
+func init() {
+	labelCmd.AddCommand(newLabelListCmd())
+}
@@ -0,0 +1,237 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+	"gopkg.in/yaml.v3"
+)
+
+// labelSpec is a single entry of a labels file, eg
+//
+//   - name: bug
+//     color: d73a4a
+//     description: Something isn't working
+//
+// Description is nil when the entry leaves it out, so that the description the
+// label has is kept.
+type labelSpec struct {
+	Name        string  `yaml:"name"`
+	Color       string  `yaml:"color"`
+	Description *string `yaml:"description"`
+}
+
+type labelChange struct {
+	action string // one of "create", "update" or "delete"
+	name   string
+	from   *github.Label
+	to     *github.Label
+}
+
+func (c labelChange) String() string {
+	switch c.action {
+	case "create":
+		line := "+ " + c.name
+		if c.to.GetColor() != "" {
+			line += fmt.Sprintf(" (#%s)", c.to.GetColor())
+		}
+		if c.to.Description != nil {
+			line += fmt.Sprintf(" %q", c.to.GetDescription())
+		}
+		return line
+	case "delete":
+		return fmt.Sprintf("- %s", c.name)
+	}
+	var diffs []string
+	if c.to.GetColor() != "" && !strings.EqualFold(c.from.GetColor(), c.to.GetColor()) {
+		diffs = append(diffs, fmt.Sprintf("color #%s -> #%s", c.from.GetColor(), c.to.GetColor()))
+	}
+	if c.to.Description != nil && c.from.GetDescription() != c.to.GetDescription() {
+		diffs = append(diffs, fmt.Sprintf("description %q -> %q", c.from.GetDescription(), c.to.GetDescription()))
+	}
+	return fmt.Sprintf("~ %s: %s", c.name, strings.Join(diffs, ", "))
+}
+
+func normaliseColor(color string) string {
+	return strings.ToLower(strings.TrimPrefix(color, "#"))
+}
+
+func readLabelFile(path string) ([]*github.Label, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading labels file: %w", err)
+	}
+	var specs []labelSpec
+	if err := yaml.Unmarshal(data, &specs); err != nil {
+		return nil, fmt.Errorf("parsing labels file %s: %w", path, err)
+	}
+	labels := make([]*github.Label, 0, len(specs))
+	seen := make(map[string]bool)
+	for _, spec := range specs {
+		if spec.Name == "" {
+			return nil, fmt.Errorf("labels file %s: every label needs a name", path)
+		}
+		key := strings.ToLower(spec.Name)
+		if seen[key] {
+			return nil, fmt.Errorf("labels file %s: label %q is listed more than once", path, spec.Name)
+		}
+		seen[key] = true
+		label := &github.Label{
+			Name:        github.String(spec.Name),
+			Description: spec.Description,
+		}
+		// Without a colour GitHub picks one for new labels and existing
+		// labels keep theirs. Descriptions are left alone in the same way.
+		if spec.Color != "" {
+			label.Color = github.String(normaliseColor(spec.Color))
+		}
+		labels = append(labels, label)
+	}
+	return labels, nil
+}
+
+// planLabelChanges works out what needs to happen to current to make it match
+// desired. Label names are matched case insensitively, like GitHub does. A
+// colour or description desired leaves unset is kept. Labels which are only in
+// current are deleted when prune is set.
+func planLabelChanges(current, desired []*github.Label, prune bool) []labelChange {
+	existing := make(map[string]*github.Label, len(current))
+	for _, label := range current {
+		existing[strings.ToLower(label.GetName())] = label
+	}
+	var changes []labelChange
+	wanted := make(map[string]bool, len(desired))
+	for _, label := range desired {
+		key := strings.ToLower(label.GetName())
+		wanted[key] = true
+		have, ok := existing[key]
+		if !ok {
+			changes = append(changes, labelChange{action: "create", name: label.GetName(), to: label})
+			continue
+		}
+		if label.GetColor() != "" && !strings.EqualFold(have.GetColor(), label.GetColor()) ||
+			label.Description != nil && have.GetDescription() != label.GetDescription() {
+			changes = append(changes, labelChange{action: "update", name: have.GetName(), from: have, to: label})
+		}
+	}
+	if prune {
+		for _, label := range current {
+			if !wanted[strings.ToLower(label.GetName())] {
+				changes = append(changes, labelChange{action: "delete", name: label.GetName(), from: label})
+			}
+		}
+	}
+	return changes
+}
+
+// applyLabelChanges prints every change to out and, unless dryRun is set,
+// makes it on repo.
+func applyLabelChanges(out io.Writer, ghApi *api.API, repo string, changes []labelChange, dryRun bool) error {
+	if len(changes) == 0 {
+		fmt.Fprintf(out, "Labels of %s are up to date\n", repo)
+		return nil
+	}
+	for _, change := range changes {
+		fmt.Fprintln(out, change)
+		if dryRun {
+			continue
+		}
+		var err error
+		switch change.action {
+		case "create":
+			_, err = ghApi.CreateLabel(repo, &github.Label{
+				Name:        change.to.Name,
+				Color:       change.to.Color,
+				Description: change.to.Description,
+			})
+		case "update":
+			_, err = ghApi.EditLabel(repo, change.name, &github.Label{
+				Color:       change.to.Color,
+				Description: change.to.Description,
+			})
+		case "delete":
+			err = ghApi.DeleteLabel(repo, change.name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newLabelSyncCmd() *cobra.Command {
+	var repo string
+	var file string
+	var prune bool
+	var dryRun bool
+	var labelSyncCmd = &cobra.Command{
+		Use:   "sync",
+		Short: "Reconcile the labels of a repository with a labels file",
+		Long: `Reconcile the labels of a repository with a labels file.
+
+Labels in the file that are missing from the repository are created and
+existing labels get the colour and description from the file, keeping their
+own when the file leaves them out. Labels that
+are not in the file are only deleted when --delete is given.
+
+The file is a YAML list of labels:
+
+  - name: bug
+    color: d73a4a
+    description: Something isn't working`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			desired, err := readLabelFile(file)
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			current, err := ghApi.ListLabels(repo)
+			if err != nil {
+				return err
+			}
+			changes := planLabelChanges(current, desired, prune)
+			return applyLabelChanges(cmd.OutOrStdout(), ghApi, repo, changes, dryRun)
+		},
+	}
+	labelSyncCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to sync labels of")
+	labelSyncCmd.Flags().StringVarP(&file, "file", "f", "", "YAML file listing the labels the repository should have")
+	labelSyncCmd.Flags().BoolVar(&prune, "delete", false, "delete labels which are not in the file")
+	labelSyncCmd.Flags().BoolVar(&dryRun, "dry-run", false, "only print the changes which would be made")
+	_ = labelSyncCmd.MarkFlagRequired("repo")
+	_ = labelSyncCmd.MarkFlagRequired("file")
+	return labelSyncCmd
+}
+
+func init() {
+	labelCmd.AddCommand(newLabelSyncCmd())
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+func TestPlanLabelChanges(t *testing.T) {
+	current := []*github.Label{
+		{Name: github.String("Bug"), Color: github.String("D73A4A"), Description: github.String("Something isn't working")},
+		{Name: github.String("enhancement"), Color: github.String("a2eeef")},
+		{Name: github.String("wontfix"), Color: github.String("ffffff")},
+	}
+	desired := []*github.Label{
+		{Name: github.String("bug"), Color: github.String("d73a4a"), Description: github.String("Something isn't working")},
+		{Name: github.String("enhancement"), Color: github.String("84b6eb"), Description: github.String("New feature")},
+		{Name: github.String("stale"), Color: github.String("cccccc"), Description: github.String("")},
+	}
+	got := planLabelChanges(current, desired, false)
+	want := []string{
+		`~ enhancement: color #a2eeef -> #84b6eb, description "" -> "New feature"`,
+		`+ stale (#cccccc) ""`,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("planLabelChanges() got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].String() != want[i] {
+			t.Errorf("change %d = %q, want %q", i, got[i].String(), want[i])
+		}
+	}
+	got = planLabelChanges(current, desired, true)
+	if last := got[len(got)-1]; last.action != "delete" || last.name != "wontfix" {
+		t.Errorf("planLabelChanges() with prune, last change = %v, want delete of wontfix", last)
+	}
+}
+
+func TestLabelSyncCmdDryRun(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if req.Method != "GET" {
+			t.Errorf("dry run made a %s request to %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`[{"name": "bug", "color": "d73a4a"}]`)),
+			Header:     make(http.Header),
+		}
+	})
+	file := filepath.Join(t.TempDir(), "labels.yml")
+	err := os.WriteFile(file, []byte("- name: bug\n  color: '#D73A4A'\n- name: stale\n  color: cccccc\n  description: No recent activity\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"label", "sync", "-r", "TheAlgorithms/Go", "-f", file, "--dry-run"})
+	err = rootCmd.Execute()
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := "+ stale (#cccccc) \"No recent activity\"\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestLabelSyncCmdWithoutColour(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	var sent []string
+	client = newTestClient(func(req *http.Request) *http.Response {
+		status, body := 200, `[{"name": "bug", "color": "d73a4a"}, {"name": "docs", "color": "0075ca", "description": "Documentation"},
+			{"name": "wontfix", "color": "ffffff", "description": "This will not be worked on"}]`
+		if req.Method != "GET" {
+			b, _ := ioutil.ReadAll(req.Body)
+			sent = append(sent, req.Method+" "+strings.TrimSpace(string(b)))
+			status, body = 200, `{}`
+			if req.Method == "POST" {
+				status = 201
+			}
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	file := filepath.Join(t.TempDir(), "labels.yml")
+	err := os.WriteFile(file, []byte("- name: bug\n  description: Something broke\n- name: docs\n- name: wontfix\n  description: \"\"\n- name: stale\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"label", "sync", "-r", "TheAlgorithms/Go", "-f", file, "--dry-run=false"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := "~ bug: description \"\" -> \"Something broke\"\n~ wontfix: description \"This will not be worked on\" -> \"\"\n+ stale\n"
+	if got := buff.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	wantSent := []string{`PATCH {"description":"Something broke"}`, `PATCH {"description":""}`, `POST {"name":"stale"}`}
+	if strings.Join(sent, "\n") != strings.Join(wantSent, "\n") {
+		t.Errorf("sent %q, want %q", sent, wantSent)
+	}
+}
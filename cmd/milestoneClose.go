@@ -0,0 +1,68 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newMilestoneCloseCmd() *cobra.Command {
+	var repo string
+	var milestoneCloseCmd = &cobra.Command{
+		Use:   "close <title>",
+		Short: "Close a milestone of the stated repository",
+		Long:  `Close a milestone of the stated repository.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			milestone, err := ghApi.FindMilestone(repo, args[0])
+			if err != nil {
+				return err
+			}
+			if milestone.GetState() == "closed" {
+				fmt.Fprintf(cmd.OutOrStdout(), "Milestone %s is already closed\n", milestone.GetTitle())
+				return nil
+			}
+			_, err = ghApi.EditMilestone(repo, milestone.GetNumber(), &github.Milestone{State: github.String("closed")})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Closed milestone %s\n", milestone.GetTitle())
+			return nil
+		},
+	}
+	milestoneCloseCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the milestone belongs to")
+	_ = milestoneCloseCmd.MarkFlagRequired("repo")
+	return milestoneCloseCmd
+}
+
+func init() {
+	milestoneCmd.AddCommand(newMilestoneCloseCmd())
+}
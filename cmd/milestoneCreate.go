@@ -0,0 +1,86 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// parseDueDate parses a due date given as YYYY-MM-DD.
+func parseDueDate(due string) (*time.Time, error) {
+	t, err := time.Parse("2006-01-02", due)
+	if err != nil {
+		return nil, fmt.Errorf("due date must be formatted as YYYY-MM-DD: %w", err)
+	}
+	return &t, nil
+}
+
+func newMilestoneCreateCmd() *cobra.Command {
+	var repo string
+	var description string
+	var due string
+	var milestoneCreateCmd = &cobra.Command{
+		Use:   "create <title>",
+		Short: "Create a milestone in the stated repository",
+		Long:  `Create a milestone in the stated repository.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			milestone := &github.Milestone{
+				Title:       github.String(args[0]),
+				Description: github.String(description),
+			}
+			if due != "" {
+				dueOn, err := parseDueDate(due)
+				if err != nil {
+					return err
+				}
+				milestone.DueOn = dueOn
+			}
+			ghApi := api.NewApi(client)
+			created, err := ghApi.CreateMilestone(repo, milestone)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Created milestone %s\n", created.GetTitle())
+			fmt.Fprintf(cmd.OutOrStdout(), "URL: %s\n", created.GetHTMLURL())
+			return nil
+		},
+	}
+	milestoneCreateCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to create the milestone in")
+	milestoneCreateCmd.Flags().StringVarP(&description, "description", "d", "", "description of the milestone")
+	milestoneCreateCmd.Flags().StringVar(&due, "due", "", "due date of the milestone as YYYY-MM-DD")
+	_ = milestoneCreateCmd.MarkFlagRequired("repo")
+	return milestoneCreateCmd
+}
+
+func init() {
+	milestoneCmd.AddCommand(newMilestoneCreateCmd())
+}
@@ -0,0 +1,95 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newMilestoneEditCmd() *cobra.Command {
+	var repo string
+	var title string
+	var description string
+	var due string
+	var state string
+	var milestoneEditCmd = &cobra.Command{
+		Use:   "edit <title>",
+		Short: "Edit a milestone of the stated repository",
+		Long:  `Edit the title, description, due date or state of a milestone of the stated repository.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			edit := &github.Milestone{}
+			if cmd.Flags().Changed("title") {
+				edit.Title = github.String(title)
+			}
+			if cmd.Flags().Changed("description") {
+				edit.Description = github.String(description)
+			}
+			if cmd.Flags().Changed("due") {
+				dueOn, err := parseDueDate(due)
+				if err != nil {
+					return err
+				}
+				edit.DueOn = dueOn
+			}
+			if cmd.Flags().Changed("state") {
+				if state != "open" && state != "closed" {
+					return fmt.Errorf("state must be open or closed")
+				}
+				edit.State = github.String(state)
+			}
+			if edit.Title == nil && edit.Description == nil && edit.DueOn == nil && edit.State == nil {
+				return fmt.Errorf("nothing to edit - specify at least one of --title, --description, --due or --state")
+			}
+			ghApi := api.NewApi(client)
+			milestone, err := ghApi.FindMilestone(repo, args[0])
+			if err != nil {
+				return err
+			}
+			edited, err := ghApi.EditMilestone(repo, milestone.GetNumber(), edit)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Edited milestone %s\n", edited.GetTitle())
+			return nil
+		},
+	}
+	milestoneEditCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the milestone belongs to")
+	milestoneEditCmd.Flags().StringVarP(&title, "title", "t", "", "new title of the milestone")
+	milestoneEditCmd.Flags().StringVarP(&description, "description", "d", "", "new description of the milestone")
+	milestoneEditCmd.Flags().StringVar(&due, "due", "", "new due date of the milestone as YYYY-MM-DD")
+	milestoneEditCmd.Flags().StringVarP(&state, "state", "s", "", "new state of the milestone - open or closed")
+	_ = milestoneEditCmd.MarkFlagRequired("repo")
+	return milestoneEditCmd
+}
+
+func init() {
+	milestoneCmd.AddCommand(newMilestoneEditCmd())
+}
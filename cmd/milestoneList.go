@@ -0,0 +1,70 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newMilestoneListCmd() *cobra.Command {
+	var repo string
+	var state string
+	var milestoneListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the milestones of the stated repository",
+		Long:  `List the milestones of the stated repository.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			milestones, err := ghApi.ListMilestones(repo, state)
+			if err != nil {
+				return err
+			}
+			for _, milestone := range milestones {
+				statusColour := green
+				if milestone.GetState() == "closed" {
+					statusColour = red
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%sStatus: %s\n", statusColour, milestone.GetState())
+				fmt.Fprintf(cmd.OutOrStdout(), "%sTitle: %s\n", statusColour, milestone.GetTitle())
+				fmt.Fprintf(cmd.OutOrStdout(), "%sURL: %s\n", statusColour, milestone.GetHTMLURL())
+				fmt.Fprintf(cmd.OutOrStdout(), "%sDue: %s\n", statusColour, formatDueDate(milestone.DueOn))
+				fmt.Fprintf(cmd.OutOrStdout(), "%sProgress: %d%% complete\n", statusColour, milestoneProgress(milestone))
+			}
+			return nil
+		},
+	}
+	milestoneListCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to retrieve milestones from")
+	milestoneListCmd.Flags().StringVarP(&state, "state", "s", "open", "state of the milestones to list - open, closed or all")
+	_ = milestoneListCmd.MarkFlagRequired("repo")
+	return milestoneListCmd
+}
+
+func init() {
+	milestoneCmd.AddCommand(newMilestoneListCmd())
+}
@@ -0,0 +1,127 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// now is swapped out by tests which depend on the current time.
+var now = time.Now
+
+// milestoneProgress returns the percentage of closed issues and PRs in the
+// milestone, rounded down like the GitHub UI does.
+func milestoneProgress(milestone *github.Milestone) int {
+	total := milestone.GetOpenIssues() + milestone.GetClosedIssues()
+	if total == 0 {
+		return 0
+	}
+	return milestone.GetClosedIssues() * 100 / total
+}
+
+func formatDueDate(dueOn *time.Time) string {
+	if dueOn == nil {
+		return "none"
+	}
+	return dueOn.Format("2006-01-02")
+}
+
+func newMilestoneViewCmd() *cobra.Command {
+	var repo string
+	var milestoneViewCmd = &cobra.Command{
+		Use:   "view <title>",
+		Short: "View the progress of a milestone",
+		Long: `View the progress of a milestone, including the number of open and closed
+issues and PRs, its due date and the items which are still open.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			milestone, err := ghApi.FindMilestone(repo, args[0])
+			if err != nil {
+				return err
+			}
+			items, err := ghApi.ListMilestoneIssues(repo, milestone.GetNumber(), "all")
+			if err != nil {
+				return err
+			}
+			var openIssues, closedIssues, openPRs, closedPRs int
+			var remaining []*github.Issue
+			for _, item := range items {
+				open := item.GetState() == "open"
+				if open {
+					remaining = append(remaining, item)
+				}
+				switch {
+				case item.IsPullRequest() && open:
+					openPRs++
+				case item.IsPullRequest():
+					closedPRs++
+				case open:
+					openIssues++
+				default:
+					closedIssues++
+				}
+			}
+			statusColour := green
+			if milestone.GetState() == "closed" {
+				statusColour = red
+			}
+			due := formatDueDate(milestone.DueOn)
+			if milestone.DueOn != nil && milestone.GetState() == "open" && milestone.DueOn.Before(now()) {
+				statusColour = red
+				due += fmt.Sprintf(" (overdue by %d days)", int(now().Sub(*milestone.DueOn).Hours()/24))
+			}
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "%sStatus: %s\n", statusColour, milestone.GetState())
+			fmt.Fprintf(out, "%sTitle: %s\n", statusColour, milestone.GetTitle())
+			fmt.Fprintf(out, "%sURL: %s\n", statusColour, milestone.GetHTMLURL())
+			fmt.Fprintf(out, "%sDue: %s\n", statusColour, due)
+			fmt.Fprintf(out, "%sProgress: %d%% complete\n", statusColour, milestoneProgress(milestone))
+			fmt.Fprintf(out, "%sIssues: %d open, %d closed\n", statusColour, openIssues, closedIssues)
+			fmt.Fprintf(out, "%sPRs: %d open, %d closed\n", statusColour, openPRs, closedPRs)
+			for _, item := range remaining {
+				kind := "issue"
+				if item.IsPullRequest() {
+					kind = "pr"
+				}
+				fmt.Fprintf(out, "%s#%d (%s) %s\n", green, item.GetNumber(), kind, item.GetTitle())
+			}
+			return nil
+		},
+	}
+	milestoneViewCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the milestone belongs to")
+	_ = milestoneViewCmd.MarkFlagRequired("repo")
+	return milestoneViewCmd
+}
+
+func init() {
+	milestoneCmd.AddCommand(newMilestoneViewCmd())
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMilestoneViewCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	oldNow := now
+	defer func() { client, now = oldClient, oldNow }()
+	now = func() time.Time { return time.Date(2022, 5, 4, 12, 0, 0, 0, time.UTC) }
+	client = newTestClient(func(req *http.Request) *http.Response {
+		var body string
+		switch req.URL.Path {
+		case "/repos/TheAlgorithms/Go/milestones":
+			body = `[{"number": 3, "title": "v1.0", "state": "open", "html_url": "https://sample.url",
+				"open_issues": 2, "closed_issues": 2, "due_on": "2022-05-01T07:00:00Z"}]`
+		case "/repos/TheAlgorithms/Go/issues":
+			body = `[
+	{"number": 1, "title": "Open issue", "state": "open"},
+	{"number": 2, "title": "Closed issue", "state": "closed"},
+	{"number": 3, "title": "Open PR", "state": "open", "pull_request": {"url": "https://sample.url"}},
+	{"number": 4, "title": "Merged PR", "state": "closed", "pull_request": {"url": "https://sample.url"}}
+]`
+		default:
+			t.Errorf("unexpected URL = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"milestone", "view", "v1.0", "-r", "TheAlgorithms/Go"})
+	err := rootCmd.Execute()
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := strings.Join([]string{
+		"\x1b[31mStatus: open",
+		"\x1b[31mTitle: v1.0",
+		"\x1b[31mURL: https://sample.url",
+		"\x1b[31mDue: 2022-05-01 (overdue by 3 days)",
+		"\x1b[31mProgress: 50% complete",
+		"\x1b[31mIssues: 1 open, 1 closed",
+		"\x1b[31mPRs: 1 open, 1 closed",
+		"\x1b[32m#1 (issue) Open issue",
+		"\x1b[32m#3 (pr) Open PR",
+		"",
+	}, "\n")
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+)
+
+// notificationsCmd represents the notifications command
+var notificationsCmd = &cobra.Command{
+	Use:   "notifications",
+	Short: "Work with your notifications",
+	Long:  `List, read and unsubscribe from the notifications of the authenticated user.`,
+}
+
+// writeNotifications prints a table of notifications, unread ones in green.
+func writeNotifications(out io.Writer, notifications []*github.Notification) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, n := range notifications {
+		colour := gray
+		if n.GetUnread() {
+			colour = green
+		}
+		fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\t%s%s\n", colour, n.GetID(), n.GetRepository().GetFullName(),
+			n.GetSubject().GetType(), n.GetSubject().GetTitle(), n.GetReason(), reset)
+	}
+	return w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(notificationsCmd)
+}
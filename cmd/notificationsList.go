@@ -0,0 +1,104 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// newNotifications returns the notifications updated since they were last
+// seen, and records them in seen.
+func newNotifications(seen map[string]time.Time, notifications []*github.Notification) []*github.Notification {
+	var fresh []*github.Notification
+	for _, n := range notifications {
+		if last, ok := seen[n.GetID()]; ok && !n.GetUpdatedAt().After(last) {
+			continue
+		}
+		seen[n.GetID()] = n.GetUpdatedAt()
+		fresh = append(fresh, n)
+	}
+	return fresh
+}
+
+func newNotificationsListCmd() *cobra.Command {
+	var opts api.NotificationOptions
+	var poll, asJSON bool
+	var notificationsListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List your unread notifications",
+		Long: `List the unread notifications of the authenticated user with the reason for
+each, the type and title of its subject and its repository.
+
+With --poll the command keeps running and prints notifications as they arrive,
+polling as often as GitHub allows. Polls which find nothing new don't count
+against the rate limit.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ghApi := api.NewApi(client)
+			out := cmd.OutOrStdout()
+			if !poll {
+				notifications, err := ghApi.ListNotifications(opts)
+				if err != nil {
+					return err
+				}
+				if asJSON {
+					return printJSON(out, notifications)
+				}
+				return writeNotifications(out, notifications)
+			}
+			if asJSON {
+				return fmt.Errorf("--json can't be used with --poll")
+			}
+			cmd.SilenceUsage = true
+			seen := make(map[string]time.Time)
+			var lastModified string
+			for {
+				result, err := ghApi.PollNotifications(opts, lastModified)
+				if err != nil {
+					return err
+				}
+				lastModified = result.LastModified
+				if fresh := newNotifications(seen, result.Notifications); len(fresh) > 0 {
+					if err := writeNotifications(out, fresh); err != nil {
+						return err
+					}
+				}
+				sleep(result.Interval)
+			}
+		},
+	}
+	notificationsListCmd.Flags().BoolVarP(&opts.All, "all", "a", false, "include notifications already read")
+	notificationsListCmd.Flags().BoolVarP(&opts.Participating, "participating", "p", false, "only list notifications for threads you participate in or are mentioned in")
+	notificationsListCmd.Flags().StringVarP(&opts.Repo, "repo", "r", "", "only list notifications of this repository")
+	notificationsListCmd.Flags().BoolVar(&poll, "poll", false, "keep polling for new notifications")
+	notificationsListCmd.Flags().BoolVar(&asJSON, "json", false, "print the notifications as JSON")
+	return notificationsListCmd
+}
+
+func init() {
+	notificationsCmd.AddCommand(newNotificationsListCmd())
+}
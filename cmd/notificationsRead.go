@@ -0,0 +1,75 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newNotificationsReadCmd() *cobra.Command {
+	var all bool
+	var repo string
+	var notificationsReadCmd = &cobra.Command{
+		Use:   "read [<thread>]",
+		Short: "Mark notifications as read",
+		Long: `Mark the notification thread with the given ID as read, or with --all every
+notification, or every notification of --repo.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if all == (len(args) == 1) {
+				return fmt.Errorf("either a thread or --all is required")
+			}
+			if repo != "" && !all {
+				return fmt.Errorf("--repo can only be used with --all")
+			}
+			ghApi := api.NewApi(client)
+			out := cmd.OutOrStdout()
+			if !all {
+				if err := ghApi.MarkThreadRead(args[0]); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "%sMarked thread %s as read\n", green, args[0])
+				return nil
+			}
+			if err := ghApi.MarkNotificationsRead(repo); err != nil {
+				return err
+			}
+			if repo != "" {
+				fmt.Fprintf(out, "%sMarked all notifications of %s as read\n", green, repo)
+			} else {
+				fmt.Fprintf(out, "%sMarked all notifications as read\n", green)
+			}
+			return nil
+		},
+	}
+	notificationsReadCmd.Flags().BoolVarP(&all, "all", "a", false, "mark every notification as read")
+	notificationsReadCmd.Flags().StringVarP(&repo, "repo", "r", "", "with --all, only mark the notifications of this repository")
+	return notificationsReadCmd
+}
+
+func init() {
+	notificationsCmd.AddCommand(newNotificationsReadCmd())
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+const testNotifications = `[
+	{"id": "1", "unread": true, "reason": "review_requested", "updated_at": "2026-10-19T10:00:00Z",
+	 "repository": {"full_name": "TheAlgorithms/Go"}, "subject": {"title": "Add sort", "type": "PullRequest"}},
+	{"id": "2", "unread": false, "reason": "mention", "updated_at": "2026-10-18T10:00:00Z",
+	 "repository": {"full_name": "TheAlgorithms/Python"}, "subject": {"title": "Crash", "type": "Issue"}}
+]`
+
+func TestNewNotifications(t *testing.T) {
+	var notifications []*github.Notification
+	if err := json.Unmarshal([]byte(testNotifications), &notifications); err != nil {
+		t.Fatal(err)
+	}
+	seen := make(map[string]time.Time)
+	if got := newNotifications(seen, notifications); len(got) != 2 {
+		t.Errorf("newNotifications() first poll = %d notifications, want 2", len(got))
+	}
+	if got := newNotifications(seen, notifications); len(got) != 0 {
+		t.Errorf("newNotifications() of seen notifications = %d notifications, want 0", len(got))
+	}
+	updated := notifications[1].GetUpdatedAt().Add(time.Hour)
+	notifications[1].UpdatedAt = &updated
+	if got := newNotifications(seen, notifications); len(got) != 1 || got[0].GetID() != "2" {
+		t.Errorf("newNotifications() of an updated notification = %v", got)
+	}
+}
+
+func TestNotificationsListCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/notifications" || req.URL.Query().Get("all") != "true" {
+			t.Errorf("unexpected request %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(testNotifications)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"notifications", "list", "--all", "--poll=false"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	want := green + "1  TheAlgorithms/Go      PullRequest  Add sort  review_requested" + reset + "\n" +
+		gray + "2  TheAlgorithms/Python  Issue        Crash     mention" + reset + "\n"
+	if buff.String() != want {
+		t.Errorf("notifications list printed %q, want %q", buff.String(), want)
+	}
+}
+
+func TestNotificationsListCmdPoll(t *testing.T) {
+	const modified = "Mon, 19 Oct 2026 10:00:00 GMT"
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	polls := 0
+	client = newTestClient(func(req *http.Request) *http.Response {
+		polls++
+		header := make(http.Header)
+		switch polls {
+		case 1:
+			if since := req.Header.Get("If-Modified-Since"); since != "" {
+				t.Errorf("first poll sent If-Modified-Since %q", since)
+			}
+			header.Set("Last-Modified", modified)
+			header.Set("X-Poll-Interval", "30")
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(testNotifications)),
+				Header:     header,
+			}
+		case 2:
+			if since := req.Header.Get("If-Modified-Since"); since != modified {
+				t.Errorf("second poll sent If-Modified-Since %q, want %q", since, modified)
+			}
+			header.Set("X-Poll-Interval", "45")
+			return &http.Response{
+				StatusCode: 304,
+				Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+				Header:     header,
+				Request:    req,
+			}
+		}
+		// Stop polling.
+		return &http.Response{
+			StatusCode: 500,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"message": "Server Error"}`)),
+			Header:     header,
+			Request:    req,
+		}
+	})
+	oldSleep := sleep
+	defer func() { sleep = oldSleep }()
+	var slept []time.Duration
+	sleep = func(d time.Duration) { slept = append(slept, d) }
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"notifications", "list", "--all=false", "--poll"})
+	if err := rootCmd.Execute(); err == nil {
+		t.Fatal("notifications list --poll didn't stop at the failing poll")
+	}
+	if polls != 3 || !reflect.DeepEqual(slept, []time.Duration{30 * time.Second, 45 * time.Second}) {
+		t.Errorf("polled %d times and slept %v, want 3 polls and sleeps of 30s and 45s", polls, slept)
+	}
+	want := green + "1  TheAlgorithms/Go      PullRequest  Add sort  review_requested" + reset + "\n" +
+		gray + "2  TheAlgorithms/Python  Issue        Crash     mention" + reset + "\n"
+	if buff.String() != want {
+		t.Errorf("notifications list --poll printed %q, want %q", buff.String(), want)
+	}
+}
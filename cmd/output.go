@@ -0,0 +1,96 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-github/github"
+)
+
+// printIssue writes the details of an issue, coloured green when it is open
+// and red when it is closed. Search results for PRs are issues too.
+func printIssue(w io.Writer, issue *github.Issue) {
+	statusColour := green
+	if issue.GetState() == "closed" {
+		statusColour = red
+	}
+	fmt.Fprintf(w, "%sStatus: %s\n", statusColour, issue.GetState())
+	fmt.Fprintf(w, "%sTitle: %s\n", statusColour, issue.GetTitle())
+	fmt.Fprintf(w, "%sURL: %s\n", statusColour, issue.GetHTMLURL())
+	fmt.Fprintf(w, "%sNumber: %d\n", statusColour, issue.GetNumber())
+	fmt.Fprintf(w, "%sBody: %s\n", statusColour, issue.GetBody())
+}
+
+// printPR writes the details of a PR in the same format as printIssue.
+func printPR(w io.Writer, pr *github.PullRequest) {
+	statusColour := green
+	if pr.GetState() == "closed" {
+		statusColour = red
+	}
+	fmt.Fprintf(w, "%sStatus: %s\n", statusColour, pr.GetState())
+	fmt.Fprintf(w, "%sTitle: %s\n", statusColour, pr.GetTitle())
+	fmt.Fprintf(w, "%sURL: %s\n", statusColour, pr.GetHTMLURL())
+	fmt.Fprintf(w, "%sNumber: %d\n", statusColour, pr.GetNumber())
+	fmt.Fprintf(w, "%sBody: %s\n", statusColour, pr.GetBody())
+}
+
+// printCommit writes the short SHA, author and subject of a commit, coloured
+// green when its signature is verified and yellow when it isn't.
+func printCommit(w io.Writer, commit *github.RepositoryCommit) {
+	verification := commit.GetCommit().GetVerification()
+	verified := "no"
+	statusColour := yellow
+	if verification.GetVerified() {
+		verified = "yes"
+		statusColour = green
+	} else if reason := verification.GetReason(); reason != "" && reason != "unsigned" {
+		verified = "no (" + reason + ")"
+	}
+	sha := commit.GetSHA()
+	if len(sha) > 7 {
+		sha = sha[:7]
+	}
+	author := commit.GetCommit().GetAuthor().GetName()
+	if login := commit.GetAuthor().GetLogin(); login != "" {
+		author += " (" + login + ")"
+	}
+	subject := strings.SplitN(commit.GetCommit().GetMessage(), "\n", 2)[0]
+	fmt.Fprintf(w, "%sSHA: %s\n", statusColour, sha)
+	fmt.Fprintf(w, "%sAuthor: %s\n", statusColour, author)
+	fmt.Fprintf(w, "%sSubject: %s\n", statusColour, subject)
+	fmt.Fprintf(w, "%sVerified: %s\n", statusColour, verified)
+}
+
+// printJSON writes v as indented JSON, for commands run with --json.
+func printJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("encoding JSON: %w", err)
+	}
+	return nil
+}
@@ -32,6 +32,7 @@ import (
 // newListPrsCmd represents the list prs command
 func newListPrsCmd() *cobra.Command {
 	var repo string
+	var asJSON bool
 	var prsCmd = &cobra.Command{
 		Use:   "prs",
 		Short: "Used to list PR's and PR related query",
@@ -45,21 +46,17 @@ func newListPrsCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if asJSON {
+				return printJSON(cmd.OutOrStdout(), prs)
+			}
 			for _, pr := range prs {
-				statusColour := green
-				if *pr.State == "closed" {
-					statusColour = red
-				}
-				fmt.Fprintf(cmd.OutOrStdout(), "%sStatus: %s\n", statusColour, *pr.State)
-				fmt.Fprintf(cmd.OutOrStdout(), "%sTitle: %s\n", statusColour, *pr.Title)
-				fmt.Fprintf(cmd.OutOrStdout(), "%sURL: %s\n", statusColour, *pr.HTMLURL)
-				fmt.Fprintf(cmd.OutOrStdout(), "%sNumber: %d\n", statusColour, *pr.Number)
-				fmt.Fprintf(cmd.OutOrStdout(), "%sBody: %s\n", statusColour, *pr.Body)
+				printPR(cmd.OutOrStdout(), pr)
 			}
 			return nil
 		},
 	}
 	prsCmd.Flags().StringVarP(&repo, "repo", "r", "", "repo name")
+	prsCmd.Flags().BoolVar(&asJSON, "json", false, "print the PRs as JSON")
 	_ = prsCmd.MarkFlagRequired("repo")
 	return prsCmd
 }
@@ -0,0 +1,135 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newPrCheckoutCmd() *cobra.Command {
+	var repo string
+	var remote string
+	var branch string
+	var force bool
+	var prCheckoutCmd = &cobra.Command{
+		Use:   "checkout <number>",
+		Short: "Check out a PR in the current directory",
+		Long: `Check out the head of a PR as a local branch in the current directory.
+
+The branch is named after the head branch of the PR unless --branch is given,
+and tracks it so that git pull and git push work. When the PR comes from a fork
+which maintainers can't push to, the branch tracks refs/pull/<number>/head of
+--remote instead, which can only be pulled.
+
+An existing branch of the same name is fast forwarded, or reset to the PR with
+--force if it has diverged.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			pr, err := ghApi.GetPR(repo, args[0])
+			if err != nil {
+				return err
+			}
+			dirty, err := gitClient.HasLocalChanges()
+			if err != nil {
+				return err
+			}
+			if dirty {
+				return fmt.Errorf("you have uncommitted changes - commit or stash them before checking out #%d", pr.GetNumber())
+			}
+
+			// Branches of repo itself, and of forks which maintainers can
+			// push to, are tracked directly. Anything else, including PRs
+			// from deleted forks, can only be fetched from the PR ref.
+			headRepo := pr.GetHead().GetRepo()
+			fetchRemote := remote
+			mergeRef := fmt.Sprintf("refs/pull/%d/head", pr.GetNumber())
+			switch {
+			case headRepo == nil:
+			case strings.EqualFold(headRepo.GetFullName(), repo):
+				mergeRef = "refs/heads/" + pr.GetHead().GetRef()
+			case pr.GetMaintainerCanModify():
+				fetchRemote = headRepo.GetCloneURL()
+				mergeRef = "refs/heads/" + pr.GetHead().GetRef()
+			}
+			local := branch
+			if local == "" {
+				local = pr.GetHead().GetRef()
+			}
+
+			exists := gitClient.BranchExists(local)
+			if exists {
+				trackedRemote, trackedRef, err := gitClient.Upstream(local)
+				if err != nil {
+					return err
+				}
+				if trackedRemote != fetchRemote || trackedRef != strings.TrimPrefix(mergeRef, "refs/heads/") {
+					return fmt.Errorf("a branch called %s already exists and doesn't track #%d - choose another name with --branch", local, pr.GetNumber())
+				}
+			}
+
+			if err := gitClient.Fetch(fetchRemote, mergeRef); err != nil {
+				return err
+			}
+			if exists {
+				if err := gitClient.Checkout(local); err != nil {
+					return err
+				}
+				if force {
+					err = gitClient.ResetHard("FETCH_HEAD")
+				} else {
+					err = gitClient.FastForward("FETCH_HEAD")
+				}
+				if err != nil {
+					return fmt.Errorf("updating %s: %w - use --force to reset it to the PR", local, err)
+				}
+			} else {
+				if err := gitClient.CheckoutNewBranch(local, "FETCH_HEAD"); err != nil {
+					return err
+				}
+				if err := gitClient.SetUpstream(local, fetchRemote, mergeRef); err != nil {
+					return err
+				}
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sChecked out #%d as %s\n", green, pr.GetNumber(), local)
+			return nil
+		},
+	}
+	prCheckoutCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	prCheckoutCmd.Flags().StringVar(&remote, "remote", "origin", "remote of the local checkout which points at the repository")
+	prCheckoutCmd.Flags().StringVarP(&branch, "branch", "b", "", "name of the local branch, defaults to the head branch of the PR")
+	prCheckoutCmd.Flags().BoolVarP(&force, "force", "f", false, "reset an existing local branch which has diverged from the PR")
+	_ = prCheckoutCmd.MarkFlagRequired("repo")
+	return prCheckoutCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrCheckoutCmd())
+}
@@ -0,0 +1,220 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// prCheck is a check run or commit status of the head of a PR.
+type prCheck struct {
+	name     string
+	state    string // pass, fail, pending or skipping
+	duration time.Duration
+	url      string
+}
+
+// checkRunState maps the status and conclusion of a check run to the state of
+// a prCheck.
+func checkRunState(run *github.CheckRun) string {
+	return conclusionState(run.GetStatus(), run.GetConclusion())
+}
+
+// conclusionState maps the status and conclusion of a check run, or of a workflow
+// run or job, to pass, fail, pending or skipping.
+func conclusionState(status, conclusion string) string {
+	if status != "completed" {
+		return "pending"
+	}
+	switch conclusion {
+	case "success":
+		return "pass"
+	case "neutral", "skipped":
+		return "skipping"
+	}
+	return "fail"
+}
+
+// collectChecks combines check runs and commit statuses, failing checks first.
+func collectChecks(runs []*github.CheckRun, status *github.CombinedStatus) []prCheck {
+	var checks []prCheck
+	for _, run := range runs {
+		check := prCheck{
+			name:  run.GetName(),
+			state: checkRunState(run),
+			url:   run.GetHTMLURL(),
+		}
+		if run.CompletedAt != nil && run.StartedAt != nil {
+			check.duration = run.CompletedAt.Sub(run.StartedAt.Time)
+		}
+		checks = append(checks, check)
+	}
+	for _, s := range status.Statuses {
+		check := prCheck{
+			name:  s.GetContext(),
+			state: "fail",
+			url:   s.GetTargetURL(),
+		}
+		switch s.GetState() {
+		case "success":
+			check.state = "pass"
+		case "pending":
+			check.state = "pending"
+		}
+		if check.state != "pending" && s.UpdatedAt != nil && s.CreatedAt != nil {
+			check.duration = s.UpdatedAt.Sub(*s.CreatedAt)
+		}
+		checks = append(checks, check)
+	}
+	order := map[string]int{"fail": 0, "pending": 1, "pass": 2, "skipping": 3}
+	sort.SliceStable(checks, func(i, j int) bool {
+		if checks[i].state != checks[j].state {
+			return order[checks[i].state] < order[checks[j].state]
+		}
+		return checks[i].name < checks[j].name
+	})
+	return checks
+}
+
+func countChecks(checks []prCheck) (failed, pending int) {
+	for _, check := range checks {
+		switch check.state {
+		case "fail":
+			failed++
+		case "pending":
+			pending++
+		}
+	}
+	return failed, pending
+}
+
+func writeChecks(w io.Writer, checks []prCheck) error {
+	colours := map[string]string{"pass": green, "fail": red, "pending": yellow, "skipping": gray}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, check := range checks {
+		duration := ""
+		if check.duration > 0 {
+			duration = check.duration.Round(time.Second).String()
+		}
+		fmt.Fprintf(tw, "%s%s\t%s\t%s\t%s%s\n", colours[check.state], check.state, check.name, duration, check.url, reset)
+	}
+	return tw.Flush()
+}
+
+func fetchChecks(ghApi *api.API, repo, sha string) ([]prCheck, error) {
+	runs, err := ghApi.ListCheckRuns(repo, sha)
+	if err != nil {
+		return nil, err
+	}
+	status, err := ghApi.GetCombinedStatus(repo, sha)
+	if err != nil {
+		return nil, err
+	}
+	return collectChecks(runs, status), nil
+}
+
+func newPrChecksCmd() *cobra.Command {
+	var repo string
+	var watch bool
+	var interval time.Duration
+	var prChecksCmd = &cobra.Command{
+		Use:   "checks <number>",
+		Short: "Show the CI checks of a PR",
+		Long: `Show the check runs and commit statuses of the head of a PR with their
+duration and a link to their details.
+
+The command fails when a check has failed or, unless --watch is given, when a
+check hasn't completed yet. With --watch it waits for every check to complete.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			if watch && interval < time.Second {
+				return fmt.Errorf("--interval has to be at least 1s")
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			pr, err := ghApi.GetPR(repo, strconv.Itoa(number))
+			if err != nil {
+				return err
+			}
+			var checks []prCheck
+			waiting := -1
+			for {
+				checks, err = fetchChecks(ghApi, repo, pr.GetHead().GetSHA())
+				if err != nil {
+					return err
+				}
+				_, pending := countChecks(checks)
+				if !watch || pending == 0 {
+					break
+				}
+				if pending != waiting {
+					fmt.Fprintf(cmd.ErrOrStderr(), "Waiting for %d of %d checks to complete\n", pending, len(checks))
+					waiting = pending
+				}
+				sleep(interval)
+			}
+
+			if len(checks) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No checks reported on the head of #%d\n", number)
+				return nil
+			}
+			if err := writeChecks(cmd.OutOrStdout(), checks); err != nil {
+				return err
+			}
+			failed, pending := countChecks(checks)
+			// The checks were shown, the usage wouldn't help.
+			cmd.SilenceUsage = true
+			if failed > 0 {
+				return fmt.Errorf("%d of %d checks failed", failed, len(checks))
+			}
+			if pending > 0 {
+				return fmt.Errorf("%d of %d checks haven't completed", pending, len(checks))
+			}
+			return nil
+		},
+	}
+	prChecksCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	prChecksCmd.Flags().BoolVarP(&watch, "watch", "w", false, "wait until every check has completed")
+	prChecksCmd.Flags().DurationVarP(&interval, "interval", "i", 10*time.Second, "time between polls with --watch, at least 1s")
+	_ = prChecksCmd.MarkFlagRequired("repo")
+	return prChecksCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrChecksCmd())
+}
@@ -0,0 +1,137 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newPrCloseCmd() *cobra.Command {
+	var repo string
+	var comment string
+	var deleteBranch, yes bool
+	var prCloseCmd = &cobra.Command{
+		Use:   "close <number>",
+		Short: "Close a PR without merging it",
+		Long: `Close a PR without merging it. With --delete-branch the head branch is deleted
+on GitHub after confirmation, as the commits of the PR may exist nowhere else.
+The local branch is kept.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			pr, err := ghApi.GetPR(repo, args[0])
+			if err != nil {
+				return err
+			}
+			if pr.GetState() != "open" {
+				return fmt.Errorf("#%d is already %s", number, pr.GetState())
+			}
+			if deleteBranch && !yes && strings.EqualFold(pr.GetHead().GetRepo().GetFullName(), repo) {
+				question := fmt.Sprintf("#%d isn't merged, delete branch %s of %s and any commits only it has?", number, pr.GetHead().GetRef(), repo)
+				ok, err := confirm(cmd, question)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					fmt.Fprintln(cmd.OutOrStdout(), "Nothing changed")
+					return nil
+				}
+			}
+			if comment != "" {
+				if _, err := ghApi.CreateComment(repo, number, comment); err != nil {
+					return err
+				}
+			}
+			closed := "closed"
+			if _, err := ghApi.EditPR(repo, number, &github.PullRequest{State: &closed}); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sClosed #%d\n", red, number)
+			if deleteBranch {
+				return deletePRBranch(cmd, ghApi, repo, pr, false)
+			}
+			return nil
+		},
+	}
+	prCloseCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	prCloseCmd.Flags().StringVarP(&comment, "comment", "c", "", "comment to leave on the PR before closing it")
+	prCloseCmd.Flags().BoolVarP(&deleteBranch, "delete-branch", "d", false, "delete the head branch on GitHub")
+	prCloseCmd.Flags().BoolVarP(&yes, "yes", "y", false, "delete the head branch without asking for confirmation")
+	_ = prCloseCmd.MarkFlagRequired("repo")
+	return prCloseCmd
+}
+
+func newPrReopenCmd() *cobra.Command {
+	var repo string
+	var prReopenCmd = &cobra.Command{
+		Use:   "reopen <number>",
+		Short: "Reopen a closed PR",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			pr, err := ghApi.GetPR(repo, args[0])
+			if err != nil {
+				return err
+			}
+			switch {
+			case pr.GetMerged():
+				return fmt.Errorf("#%d is merged and can't be reopened", number)
+			case pr.GetState() == "open":
+				return fmt.Errorf("#%d is already open", number)
+			}
+			open := "open"
+			if _, err := ghApi.EditPR(repo, number, &github.PullRequest{State: &open}); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sReopened #%d\n", green, number)
+			return nil
+		},
+	}
+	prReopenCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	_ = prReopenCmd.MarkFlagRequired("repo")
+	return prReopenCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrCloseCmd())
+	prCmd.AddCommand(newPrReopenCmd())
+}
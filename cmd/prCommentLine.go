@@ -0,0 +1,81 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newPrCommentLineCmd() *cobra.Command {
+	var repo string
+	var comment api.DraftComment
+	var prCommentLineCmd = &cobra.Command{
+		Use:   "comment-line <number>",
+		Short: "Comment on a line of a PR",
+		Long: `Add a comment on a line of the diff of a PR to your pending review. The
+comments are only published when the review is submitted with pr review, so
+all of them arrive in a single review.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			if comment.Line < 1 {
+				return fmt.Errorf("--line has to be a positive line number")
+			}
+			comment.Side = strings.ToUpper(comment.Side)
+			if comment.Side != "RIGHT" && comment.Side != "LEFT" {
+				return fmt.Errorf("--side has to be RIGHT or LEFT, not %q", comment.Side)
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			if err := ghApi.AddDraftComment(repo, number, &comment); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sAdded a comment on %s:%d to your pending review of #%d\n", green, comment.Path, comment.Line, number)
+			fmt.Fprintf(cmd.OutOrStdout(), "%sSubmit it with: ghcli pr review %d --repo %s --comment|--approve|--request-changes\n", reset, number, repo)
+			return nil
+		},
+	}
+	prCommentLineCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	prCommentLineCmd.Flags().StringVarP(&comment.Path, "file", "f", "", "path of the file to comment on")
+	prCommentLineCmd.Flags().IntVarP(&comment.Line, "line", "l", 0, "line of the file to comment on")
+	prCommentLineCmd.Flags().StringVar(&comment.Side, "side", "RIGHT", "side of the diff the line is on, RIGHT for the new file or LEFT for the old one")
+	prCommentLineCmd.Flags().StringVarP(&comment.Body, "body", "b", "", "text of the comment")
+	_ = prCommentLineCmd.MarkFlagRequired("repo")
+	_ = prCommentLineCmd.MarkFlagRequired("file")
+	_ = prCommentLineCmd.MarkFlagRequired("line")
+	_ = prCommentLineCmd.MarkFlagRequired("body")
+	return prCommentLineCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrCommentLineCmd())
+}
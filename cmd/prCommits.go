@@ -0,0 +1,70 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newPrCommitsCmd() *cobra.Command {
+	var repo string
+	var asJSON bool
+	var prCommitsCmd = &cobra.Command{
+		Use:   "commits <number>",
+		Short: "List the commits of a PR",
+		Long:  `List the commits of a PR, oldest first, with their author and whether their signature is verified.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			commits, err := ghApi.ListPRCommits(repo, number)
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				return printJSON(cmd.OutOrStdout(), commits)
+			}
+			for _, commit := range commits {
+				printCommit(cmd.OutOrStdout(), commit)
+			}
+			return nil
+		},
+	}
+	prCommitsCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	prCommitsCmd.Flags().BoolVar(&asJSON, "json", false, "print the commits as JSON")
+	_ = prCommitsCmd.MarkFlagRequired("repo")
+	return prCommitsCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrCommitsCmd())
+}
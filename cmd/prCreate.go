@@ -0,0 +1,246 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+	"github.com/tjgurwara99/ghcli/git"
+)
+
+// prTemplateDirs are the directories GitHub looks for a PR template in,
+// relative to the root of the repository.
+var prTemplateDirs = []string{".github", ".", "docs"}
+
+// findPRTemplate returns the contents of the PR template of the checkout at
+// root, or an empty string when it doesn't have one.
+func findPRTemplate(root string) (string, error) {
+	for _, dir := range prTemplateDirs {
+		entries, err := os.ReadDir(filepath.Join(root, dir))
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.EqualFold(entry.Name(), "pull_request_template.md") {
+				continue
+			}
+			data, err := os.ReadFile(filepath.Join(root, dir, entry.Name()))
+			if err != nil {
+				return "", fmt.Errorf("reading PR template: %w", err)
+			}
+			return string(data), nil
+		}
+	}
+	return "", nil
+}
+
+// fillFromCommits derives a title and body from the commits of a branch the
+// way GitHub does: a single commit provides both, otherwise the title comes
+// from the branch name and the body lists the commit subjects.
+func fillFromCommits(branch string, commits []git.Commit) (title, body string) {
+	if len(commits) == 1 {
+		return commits[0].Subject, commits[0].Body
+	}
+	title = strings.NewReplacer("-", " ", "_", " ").Replace(branch)
+	if title != "" {
+		title = strings.ToUpper(title[:1]) + title[1:]
+	}
+	var lines []string
+	for _, commit := range commits {
+		lines = append(lines, "- "+commit.Subject)
+	}
+	return title, strings.Join(lines, "\n")
+}
+
+func newPrCreateCmd() *cobra.Command {
+	var repo string
+	var title string
+	var body string
+	var fill bool
+	var base string
+	var head string
+	var draft bool
+	var noPush bool
+	var remote string
+	var reviewers []string
+	var labels []string
+	var assignees []string
+	var prCreateCmd = &cobra.Command{
+		Use:   "create",
+		Short: "Open a PR for the current branch",
+		Long: `Open a PR for the branch checked out in the current directory.
+
+The branch is pushed first if it has commits which its upstream doesn't have,
+or to --remote if it has no upstream yet. The PR targets the default branch of
+the repository unless --base is given. The body defaults to the PR template of
+the repository, if it has one.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			out := cmd.OutOrStdout()
+			branch, err := gitClient.CurrentBranch()
+			if err != nil {
+				return err
+			}
+			upstreamRemote, upstreamBranch, err := gitClient.Upstream(branch)
+			if err != nil {
+				return err
+			}
+			remoteBranch := branch
+			if upstreamBranch != "" {
+				remoteBranch = upstreamBranch
+			}
+			prHead := head
+			if prHead == "" {
+				prHead = remoteBranch
+			}
+
+			ghApi := api.NewApi(client)
+			prBase := base
+			if prBase == "" {
+				r, err := ghApi.GetRepo(repo)
+				if err != nil {
+					return err
+				}
+				prBase = r.GetDefaultBranch()
+			}
+			if prHead == prBase {
+				return fmt.Errorf("the head branch %s is the base branch - check out the branch the PR is for", prHead)
+			}
+			existing, err := ghApi.FindPRForHead(repo, prHead)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				return fmt.Errorf("a PR for %s already exists: %s", prHead, existing.GetHTMLURL())
+			}
+
+			pushRemote := remote
+			if upstreamRemote != "" {
+				pushRemote = upstreamRemote
+			}
+			if !noPush {
+				local, err := gitClient.RevParse("HEAD")
+				if err != nil {
+					return err
+				}
+				// The remote branch not existing yet isn't an error, it
+				// just needs pushing.
+				pushed, _ := gitClient.RevParse(pushRemote + "/" + remoteBranch)
+				if upstreamRemote == "" || pushed != local {
+					fmt.Fprintf(out, "Pushing %s to %s\n", branch, pushRemote)
+					if err := gitClient.Push(pushRemote, remoteBranch, upstreamRemote == ""); err != nil {
+						return err
+					}
+				}
+			}
+
+			if fill {
+				commits, err := gitClient.Commits(pushRemote+"/"+prBase, "HEAD")
+				if err != nil {
+					return err
+				}
+				if len(commits) == 0 {
+					return fmt.Errorf("%s has no commits which %s/%s doesn't have", branch, pushRemote, prBase)
+				}
+				filledTitle, filledBody := fillFromCommits(branch, commits)
+				if !cmd.Flags().Changed("title") {
+					title = filledTitle
+				}
+				if !cmd.Flags().Changed("body") {
+					body = filledBody
+				}
+			}
+			if title == "" {
+				return fmt.Errorf("title is required - use --title or --fill")
+			}
+			if !cmd.Flags().Changed("body") {
+				root, err := gitClient.TopLevel()
+				if err != nil {
+					return err
+				}
+				template, err := findPRTemplate(root)
+				if err != nil {
+					return err
+				}
+				if template != "" && body != "" {
+					body += "\n\n"
+				}
+				body += template
+			}
+
+			pr, err := ghApi.CreatePR(repo, &api.NewPullRequest{
+				Title: title,
+				Head:  prHead,
+				Base:  prBase,
+				Body:  body,
+				Draft: draft,
+			})
+			if err != nil {
+				return err
+			}
+			if len(reviewers) > 0 {
+				if err := ghApi.RequestReviewers(repo, pr.GetNumber(), reviewers); err != nil {
+					return err
+				}
+			}
+			if len(labels) > 0 {
+				if err := ghApi.AddLabels(repo, pr.GetNumber(), labels); err != nil {
+					return err
+				}
+			}
+			if len(assignees) > 0 {
+				if err := ghApi.AddAssignees(repo, pr.GetNumber(), assignees); err != nil {
+					return err
+				}
+			}
+			fmt.Fprintf(out, "%sCreated PR #%d\n", green, pr.GetNumber())
+			fmt.Fprintf(out, "%sURL: %s\n", green, pr.GetHTMLURL())
+			return nil
+		},
+	}
+	prCreateCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to open the PR in")
+	prCreateCmd.Flags().StringVarP(&title, "title", "t", "", "title of the PR")
+	prCreateCmd.Flags().StringVarP(&body, "body", "b", "", "body of the PR")
+	prCreateCmd.Flags().BoolVarP(&fill, "fill", "f", false, "use the commit messages of the branch for the title and body")
+	prCreateCmd.Flags().StringVarP(&base, "base", "B", "", "branch to merge into, defaults to the default branch of the repository")
+	prCreateCmd.Flags().StringVarP(&head, "head", "H", "", "branch to open the PR for as 'branch' or 'owner:branch', defaults to the current branch")
+	prCreateCmd.Flags().BoolVarP(&draft, "draft", "d", false, "open the PR as a draft")
+	prCreateCmd.Flags().BoolVar(&noPush, "no-push", false, "don't push the current branch")
+	prCreateCmd.Flags().StringVar(&remote, "remote", "origin", "remote to push to when the branch has no upstream")
+	prCreateCmd.Flags().StringSliceVar(&reviewers, "reviewer", nil, "users or teams, as 'org/team', to request reviews from")
+	prCreateCmd.Flags().StringSliceVar(&labels, "label", nil, "labels to add to the PR")
+	prCreateCmd.Flags().StringSliceVar(&assignees, "assignee", nil, "users to assign to the PR")
+	_ = prCreateCmd.MarkFlagRequired("repo")
+	return prCreateCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrCreateCmd())
+}
@@ -0,0 +1,163 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// colourDiff colours added lines green, removed lines red and hunk headers
+// yellow.
+func colourDiff(diff string) string {
+	lines := strings.SplitAfter(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		case strings.HasPrefix(line, "+"):
+			lines[i] = colourLine(green, line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = colourLine(red, line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = colourLine(yellow, line)
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+// colourLine resets the colour before the line ending, so pagers don't carry
+// it over to the next line.
+func colourLine(colour, line string) string {
+	text := strings.TrimSuffix(line, "\n")
+	return colour + text + reset + line[len(text):]
+}
+
+// writeDiffStat summarises files like git diff --stat does.
+func writeDiffStat(w io.Writer, files []*github.CommitFile, colour bool) {
+	const maxBar = 50
+	width, most, additions, deletions := 0, 0, 0, 0
+	for _, file := range files {
+		if len(file.GetFilename()) > width {
+			width = len(file.GetFilename())
+		}
+		if file.GetChanges() > most {
+			most = file.GetChanges()
+		}
+		additions += file.GetAdditions()
+		deletions += file.GetDeletions()
+	}
+	plus, minus, end := "+", "-", ""
+	if colour {
+		plus, minus, end = green+"+", red+"-", reset
+	}
+	for _, file := range files {
+		adds, dels := file.GetAdditions(), file.GetDeletions()
+		if most > maxBar {
+			adds = adds * maxBar / most
+			dels = dels * maxBar / most
+		}
+		fmt.Fprintf(w, " %-*s | %d %s%s%s\n", width, file.GetFilename(), file.GetChanges(),
+			strings.Repeat(plus, adds), strings.Repeat(minus, dels), end)
+	}
+	fmt.Fprintf(w, " %d files changed, %d insertions(+), %d deletions(-)\n", len(files), additions, deletions)
+}
+
+func newPrDiffCmd() *cobra.Command {
+	var repo string
+	var colour string
+	var nameOnly bool
+	var stat bool
+	var patch bool
+	var prDiffCmd = &cobra.Command{
+		Use:   "diff <number>",
+		Short: "View the changes of a PR",
+		Long: `View the changes of a PR as a unified diff.
+
+Output to a terminal is shown through $PAGER, or less when PAGER isn't set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			var useColour bool
+			switch colour {
+			case "always":
+				useColour = true
+			case "never":
+			case "auto":
+				useColour = isTerminal(cmd.OutOrStdout())
+			default:
+				return fmt.Errorf("color must be one of auto, always or never")
+			}
+			ghApi := api.NewApi(client)
+			var files []*github.CommitFile
+			var diff string
+			if nameOnly || stat {
+				files, err = listPRFiles(cmd, ghApi, repo, number)
+			} else {
+				diff, err = ghApi.GetPRDiff(repo, number, patch)
+			}
+			if err != nil {
+				return err
+			}
+
+			out, wait, err := startPager(cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			switch {
+			case nameOnly:
+				for _, file := range files {
+					fmt.Fprintln(out, file.GetFilename())
+				}
+			case stat:
+				writeDiffStat(out, files, useColour)
+			case useColour:
+				fmt.Fprint(out, colourDiff(diff))
+			default:
+				fmt.Fprint(out, diff)
+			}
+			return wait()
+		},
+	}
+	prDiffCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	prDiffCmd.Flags().StringVar(&colour, "color", "auto", "colour the diff - auto, always or never")
+	prDiffCmd.Flags().BoolVar(&nameOnly, "name-only", false, "only list the names of the changed files")
+	prDiffCmd.Flags().BoolVar(&stat, "stat", false, "summarise the changes of every file")
+	prDiffCmd.Flags().BoolVar(&patch, "patch", false, "show the commits as patches in the git format-patch format")
+	_ = prDiffCmd.MarkFlagRequired("repo")
+	return prDiffCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrDiffCmd())
+}
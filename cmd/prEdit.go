@@ -0,0 +1,117 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newPrEditCmd() *cobra.Command {
+	var repo string
+	var title, body, base string
+	var addReviewers, removeReviewers []string
+	var addAssignees, addLabels []string
+	var prEditCmd = &cobra.Command{
+		Use:   "edit <number>",
+		Short: "Edit a PR",
+		Long: `Edit the title, body or base branch of a PR, request or withdraw reviews
+and add assignees or labels. Teams are given as org/team.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			var edit github.PullRequest
+			if cmd.Flags().Changed("title") {
+				edit.Title = &title
+			}
+			if cmd.Flags().Changed("body") {
+				edit.Body = &body
+			}
+			if base != "" {
+				edit.Base = &github.PullRequestBranch{Ref: &base}
+			}
+			changed := edit.Title != nil || edit.Body != nil || edit.Base != nil
+			if !changed && len(addReviewers)+len(removeReviewers)+len(addAssignees)+len(addLabels) == 0 {
+				return fmt.Errorf("nothing to edit - give at least one flag")
+			}
+
+			ghApi := api.NewApi(client)
+			out := cmd.OutOrStdout()
+			if changed {
+				if _, err := ghApi.EditPR(repo, number, &edit); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "%sEdited #%d\n", green, number)
+			}
+			if len(addReviewers) > 0 {
+				if err := ghApi.RequestReviewers(repo, number, addReviewers); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "%sRequested reviews from %s\n", green, strings.Join(addReviewers, ", "))
+			}
+			if len(removeReviewers) > 0 {
+				if err := ghApi.RemoveReviewers(repo, number, removeReviewers); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "%sWithdrew review requests from %s\n", green, strings.Join(removeReviewers, ", "))
+			}
+			if len(addAssignees) > 0 {
+				if err := ghApi.AddAssignees(repo, number, addAssignees); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "%sAssigned %s\n", green, strings.Join(addAssignees, ", "))
+			}
+			if len(addLabels) > 0 {
+				if err := ghApi.AddLabels(repo, number, addLabels); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "%sAdded labels %s\n", green, strings.Join(addLabels, ", "))
+			}
+			return nil
+		},
+	}
+	prEditCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	prEditCmd.Flags().StringVarP(&title, "title", "t", "", "new title")
+	prEditCmd.Flags().StringVarP(&body, "body", "b", "", "new body")
+	prEditCmd.Flags().StringVarP(&base, "base", "B", "", "branch to merge the PR into instead")
+	prEditCmd.Flags().StringSliceVar(&addReviewers, "add-reviewer", nil, "request reviews from users or org/teams")
+	prEditCmd.Flags().StringSliceVar(&removeReviewers, "remove-reviewer", nil, "withdraw review requests from users or org/teams")
+	prEditCmd.Flags().StringSliceVar(&addAssignees, "add-assignee", nil, "assign users")
+	prEditCmd.Flags().StringSliceVar(&addLabels, "add-label", nil, "add labels")
+	_ = prEditCmd.MarkFlagRequired("repo")
+	return prEditCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrEditCmd())
+}
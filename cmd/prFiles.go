@@ -0,0 +1,86 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// listPRFiles lists every file a PR changes, warning when GitHub didn't list
+// all of them.
+func listPRFiles(cmd *cobra.Command, ghApi *api.API, repo string, number int) ([]*github.CommitFile, error) {
+	pr, err := ghApi.GetPR(repo, strconv.Itoa(number))
+	if err != nil {
+		return nil, err
+	}
+	files, err := ghApi.ListPRFiles(repo, number)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) < pr.GetChangedFiles() {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: GitHub only lists %d of the %d files changed by #%d\n", len(files), pr.GetChangedFiles(), number)
+	}
+	return files, nil
+}
+
+func newPrFilesCmd() *cobra.Command {
+	var repo string
+	var prFilesCmd = &cobra.Command{
+		Use:   "files <number>",
+		Short: "List the files changed by a PR",
+		Long:  `List the files changed by a PR with their status and the number of added and deleted lines.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			files, err := listPRFiles(cmd, ghApi, repo, number)
+			if err != nil {
+				return err
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			for _, file := range files {
+				fmt.Fprintf(w, "%s\t+%d\t-%d\t%s\n", file.GetStatus(), file.GetAdditions(), file.GetDeletions(), file.GetFilename())
+			}
+			return w.Flush()
+		},
+	}
+	prFilesCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	_ = prFilesCmd.MarkFlagRequired("repo")
+	return prFilesCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrFilesCmd())
+}
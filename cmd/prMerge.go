@@ -0,0 +1,241 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// mergeBlocker explains why pr can't be merged, or returns an empty string
+// when it can. checks are the check runs and statuses of its head. With auto
+// set, the requirements GitHub waits for before auto-merging are ignored.
+func mergeBlocker(pr *github.PullRequest, checks []prCheck, auto bool) string {
+	switch {
+	case pr.GetMerged():
+		return "it is already merged"
+	case pr.GetState() == "closed":
+		return "it is closed"
+	case pr.GetMergeableState() == "draft":
+		return "it is a draft"
+	case pr.GetMergeableState() == "dirty":
+		return fmt.Sprintf("it has conflicts with %s", pr.GetBase().GetRef())
+	case pr.Mergeable == nil || pr.GetMergeableState() == "unknown":
+		return "GitHub is still working out whether it can be merged - try again in a moment"
+	case auto:
+		return ""
+	}
+	var failing []string
+	for _, check := range checks {
+		if check.state == "fail" || check.state == "pending" {
+			failing = append(failing, fmt.Sprintf("%s (%s)", check.name, check.state))
+		}
+	}
+	if len(failing) > 0 {
+		return "its checks haven't passed: " + strings.Join(failing, ", ")
+	}
+	switch pr.GetMergeableState() {
+	case "blocked":
+		return "it is blocked by branch protection - required reviews or checks are missing"
+	case "behind":
+		return fmt.Sprintf("its head branch is behind %s", pr.GetBase().GetRef())
+	case "unstable":
+		return "some of its checks are failing"
+	}
+	return ""
+}
+
+func newPrMergeCmd() *cobra.Command {
+	var repo string
+	var merge, squash, rebase bool
+	var subject string
+	var body string
+	var deleteBranch bool
+	var auto bool
+	var matchHead string
+	var prMergeCmd = &cobra.Command{
+		Use:   "merge <number>",
+		Short: "Merge a PR",
+		Long: `Merge a PR with a merge commit (the default), by squashing or by rebasing.
+
+Merging is refused when the PR has conflicts, its checks haven't passed or
+branch protection blocks it, eg because reviews are missing. With --auto GitHub
+merges the PR once it meets those requirements instead, or merges it straight
+away if it already does.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			opt := &api.MergeOptions{
+				Method:  "merge",
+				Subject: subject,
+				Body:    body,
+				SHA:     matchHead,
+			}
+			methods := 0
+			for method, set := range map[string]bool{"merge": merge, "squash": squash, "rebase": rebase} {
+				if set {
+					opt.Method = method
+					methods++
+				}
+			}
+			if methods > 1 {
+				return fmt.Errorf("only one of --merge, --squash or --rebase can be given")
+			}
+			if auto && deleteBranch {
+				return fmt.Errorf("--delete-branch can't be used with --auto - enable automatic branch deletion in the repository settings instead")
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			pr, err := ghApi.GetPR(repo, args[0])
+			if err != nil {
+				return err
+			}
+			if matchHead != "" && matchHead != pr.GetHead().GetSHA() {
+				return fmt.Errorf("can't merge #%d: its head is %s, not %s", number, pr.GetHead().GetSHA(), matchHead)
+			}
+			out := cmd.OutOrStdout()
+			// GitHub refuses to enable auto-merge for a PR which can already be
+			// merged, so merge it straight away instead.
+			autoMerge := auto && pr.GetMergeableState() != "clean"
+			if auto && !autoMerge {
+				fmt.Fprintf(out, "#%d can already be merged, merging it now\n", number)
+			}
+			var checks []prCheck
+			if !autoMerge {
+				checks, err = fetchChecks(ghApi, repo, pr.GetHead().GetSHA())
+				if err != nil {
+					return err
+				}
+			}
+			if reason := mergeBlocker(pr, checks, autoMerge); reason != "" {
+				return fmt.Errorf("can't merge #%d: %s", number, reason)
+			}
+
+			if autoMerge {
+				if err := ghApi.EnableAutoMerge(pr.GetNodeID(), opt); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "%sAuto-merge enabled for #%d\n", green, number)
+				return nil
+			}
+			result, err := ghApi.MergePR(repo, number, opt)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "%sMerged #%d as %s\n", green, number, result.GetSHA())
+			if deleteBranch {
+				return deletePRBranch(cmd, ghApi, repo, pr, true)
+			}
+			return nil
+		},
+	}
+	prMergeCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	prMergeCmd.Flags().BoolVar(&merge, "merge", false, "merge with a merge commit")
+	prMergeCmd.Flags().BoolVar(&squash, "squash", false, "squash the commits into one")
+	prMergeCmd.Flags().BoolVar(&rebase, "rebase", false, "rebase the commits onto the base branch")
+	prMergeCmd.Flags().StringVarP(&subject, "subject", "t", "", "subject of the merge or squash commit")
+	prMergeCmd.Flags().StringVarP(&body, "body", "b", "", "body of the merge or squash commit")
+	prMergeCmd.Flags().BoolVarP(&deleteBranch, "delete-branch", "d", false, "delete the head branch on GitHub and locally after merging")
+	prMergeCmd.Flags().BoolVar(&auto, "auto", false, "merge automatically once the requirements are met")
+	prMergeCmd.Flags().StringVar(&matchHead, "match-head-commit", "", "only merge if this is the SHA of the head of the PR")
+	_ = prMergeCmd.MarkFlagRequired("repo")
+	return prMergeCmd
+}
+
+// isPRBranch reports whether the local branch holds the head of pr, because
+// it points at the head commit or tracks the head branch.
+func isPRBranch(branch string, pr *github.PullRequest) bool {
+	if sha, err := gitClient.RevParse(branch); err == nil && sha == pr.GetHead().GetSHA() {
+		return true
+	}
+	remote, ref, err := gitClient.Upstream(branch)
+	if err != nil || remote == "" {
+		return false
+	}
+	// pr checkout sets the remote to a URL for forks without a remote.
+	url := remote
+	if remoteURL, err := gitClient.RemoteURL(remote); err == nil && remoteURL != "" {
+		url = remoteURL
+	}
+	tracked, err := parseRepoURL(url)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(tracked, pr.GetHead().GetRepo().GetFullName()) && ref == pr.GetHead().GetRef() {
+		return true
+	}
+	return strings.EqualFold(tracked, pr.GetBase().GetRepo().GetFullName()) &&
+		ref == fmt.Sprintf("refs/pull/%d/head", pr.GetNumber())
+}
+
+// deletePRBranch deletes the head branch of a PR on GitHub, unless it belongs
+// to a fork, and in the local checkout. The local branch is only deleted when
+// it holds the head of a merged PR and git agrees it is merged; the base
+// branch is checked out first if it is checked out.
+func deletePRBranch(cmd *cobra.Command, ghApi *api.API, repo string, pr *github.PullRequest, merged bool) error {
+	out := cmd.OutOrStdout()
+	branch := pr.GetHead().GetRef()
+	if strings.EqualFold(pr.GetHead().GetRepo().GetFullName(), repo) {
+		if err := ghApi.DeleteBranch(repo, branch); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "%sDeleted branch %s of %s\n", green, branch, repo)
+	} else {
+		fmt.Fprintf(out, "Not deleting %s, it belongs to %s\n", branch, pr.GetHead().GetRepo().GetFullName())
+	}
+	if !gitClient.BranchExists(branch) {
+		return nil
+	}
+	if !merged {
+		fmt.Fprintf(out, "Not deleting local branch %s, #%d isn't merged\n", branch, pr.GetNumber())
+		return nil
+	}
+	if !isPRBranch(branch, pr) {
+		fmt.Fprintf(out, "Not deleting local branch %s, it isn't the head of #%d\n", branch, pr.GetNumber())
+		return nil
+	}
+	if current, err := gitClient.CurrentBranch(); err == nil && current == branch {
+		if err := gitClient.Checkout(pr.GetBase().GetRef()); err != nil {
+			return err
+		}
+	}
+	if err := gitClient.DeleteBranch(branch); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: not deleting local branch %s: %v\n", branch, err)
+		return nil
+	}
+	fmt.Fprintf(out, "%sDeleted local branch %s\n", green, branch)
+	return nil
+}
+
+func init() {
+	prCmd.AddCommand(newPrMergeCmd())
+}
@@ -0,0 +1,79 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newPrReadyCmd() *cobra.Command {
+	var repo string
+	var undo bool
+	var prReadyCmd = &cobra.Command{
+		Use:   "ready <number>",
+		Short: "Mark a draft PR as ready for review",
+		Long:  `Mark a draft PR as ready for review, or turn a PR back into a draft with --undo.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			pr, err := ghApi.GetPR(repo, strconv.Itoa(number))
+			if err != nil {
+				return err
+			}
+			if pr.GetState() != "open" {
+				return fmt.Errorf("#%d is %s", number, pr.GetState())
+			}
+			if undo {
+				if err := ghApi.ConvertPRToDraft(pr.GetNodeID()); err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%sConverted #%d to a draft\n", yellow, number)
+				return nil
+			}
+			if err := ghApi.MarkPRReady(pr.GetNodeID()); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sMarked #%d as ready for review\n", green, number)
+			return nil
+		},
+	}
+	prReadyCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	prReadyCmd.Flags().BoolVar(&undo, "undo", false, "convert the PR to a draft")
+	_ = prReadyCmd.MarkFlagRequired("repo")
+	return prReadyCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrReadyCmd())
+}
@@ -0,0 +1,121 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// pendingReview returns the review of a PR the authenticated user has started
+// but not submitted yet, or nil if there isn't one. Other users' pending
+// reviews aren't listed.
+func pendingReview(ghApi *api.API, repo string, number int) (*github.PullRequestReview, error) {
+	reviews, err := ghApi.ListReviews(repo, number)
+	if err != nil {
+		return nil, err
+	}
+	for _, review := range reviews {
+		if review.GetState() == "PENDING" {
+			return review, nil
+		}
+	}
+	return nil, nil
+}
+
+func newPrReviewCmd() *cobra.Command {
+	var repo string
+	var approve, requestChanges, comment bool
+	var body string
+	var prReviewCmd = &cobra.Command{
+		Use:   "review <number>",
+		Short: "Approve, request changes on or comment on a PR",
+		Long: `Submit a review of a PR. Exactly one of --approve, --request-changes or
+--comment has to be given, and the last two need a --body.
+
+Line comments added with pr comment-line are submitted as part of the review.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			var event string
+			events := 0
+			for e, set := range map[string]bool{"APPROVE": approve, "REQUEST_CHANGES": requestChanges, "COMMENT": comment} {
+				if set {
+					event = e
+					events++
+				}
+			}
+			if events != 1 {
+				return fmt.Errorf("exactly one of --approve, --request-changes or --comment has to be given")
+			}
+			if event != "APPROVE" && body == "" {
+				return fmt.Errorf("--body is required when requesting changes or commenting")
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			pending, err := pendingReview(ghApi, repo, number)
+			if err != nil {
+				return err
+			}
+			var review *github.PullRequestReview
+			if pending != nil {
+				review, err = ghApi.SubmitReview(repo, number, pending.GetID(), event, body)
+			} else {
+				review, err = ghApi.CreateReview(repo, number, event, body)
+			}
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			switch event {
+			case "APPROVE":
+				fmt.Fprintf(out, "%sApproved #%d\n", green, number)
+			case "REQUEST_CHANGES":
+				fmt.Fprintf(out, "%sRequested changes on #%d\n", red, number)
+			default:
+				fmt.Fprintf(out, "%sCommented on #%d\n", reset, number)
+			}
+			fmt.Fprintf(out, "%sURL: %s\n", reset, review.GetHTMLURL())
+			return nil
+		},
+	}
+	prReviewCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	prReviewCmd.Flags().BoolVar(&approve, "approve", false, "approve the PR")
+	prReviewCmd.Flags().BoolVar(&requestChanges, "request-changes", false, "request changes on the PR")
+	prReviewCmd.Flags().BoolVar(&comment, "comment", false, "comment on the PR without approving it")
+	prReviewCmd.Flags().StringVarP(&body, "body", "b", "", "body of the review")
+	_ = prReviewCmd.MarkFlagRequired("repo")
+	return prReviewCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrReviewCmd())
+}
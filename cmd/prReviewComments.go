@@ -0,0 +1,132 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// hunkLines is the number of lines of the diff hunk shown above a thread,
+// the commented line being the last of them.
+const hunkLines = 4
+
+// trimHunk keeps the last hunkLines lines of a diff hunk.
+func trimHunk(hunk string) string {
+	lines := strings.Split(strings.TrimSuffix(hunk, "\n"), "\n")
+	if len(lines) > hunkLines {
+		lines = lines[len(lines)-hunkLines:]
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// writeReviewThreads writes threads grouped by the file they are on.
+func writeReviewThreads(w io.Writer, threads []*api.ReviewThread) {
+	sort.SliceStable(threads, func(i, j int) bool {
+		return threads[i].Path < threads[j].Path
+	})
+	path := ""
+	for _, thread := range threads {
+		if thread.Path != path {
+			path = thread.Path
+			fmt.Fprintf(w, "%s%s\n", reset, path)
+		}
+		state, colour := "open", yellow
+		if thread.IsResolved {
+			state, colour = "resolved", green
+		}
+		if thread.IsOutdated {
+			state += ", outdated"
+		}
+		if thread.Line > 0 {
+			fmt.Fprintf(w, "%s  Line %d (%s)\n", colour, thread.Line, state)
+		} else {
+			fmt.Fprintf(w, "%s  (%s)\n", colour, state)
+		}
+		if len(thread.Comments) > 0 {
+			for _, line := range strings.SplitAfter(colourDiff(trimHunk(thread.Comments[0].DiffHunk)), "\n") {
+				if line != "" {
+					fmt.Fprintf(w, "    %s", line)
+				}
+			}
+		}
+		for _, comment := range thread.Comments {
+			fmt.Fprintf(w, "%s    %s (%s): %s\n", reset, comment.Author, comment.CreatedAt.Format("2006-01-02 15:04"),
+				strings.ReplaceAll(strings.TrimSpace(comment.Body), "\n", "\n      "))
+		}
+	}
+}
+
+func newPrReviewCommentsCmd() *cobra.Command {
+	var repo string
+	var unresolved bool
+	var prReviewCommentsCmd = &cobra.Command{
+		Use:   "review-comments <number>",
+		Short: "List the line comments of a PR",
+		Long: `List the review comments on lines of a PR grouped by file and thread, with
+the part of the diff they comment on and whether the thread is resolved.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			threads, err := ghApi.ListReviewThreads(repo, number)
+			if err != nil {
+				return err
+			}
+			if unresolved {
+				var open []*api.ReviewThread
+				for _, thread := range threads {
+					if !thread.IsResolved {
+						open = append(open, thread)
+					}
+				}
+				threads = open
+			}
+			if len(threads) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No review comments on #%d\n", number)
+				return nil
+			}
+			writeReviewThreads(cmd.OutOrStdout(), threads)
+			return nil
+		},
+	}
+	prReviewCommentsCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	prReviewCommentsCmd.Flags().BoolVar(&unresolved, "unresolved", false, "only list threads which aren't resolved")
+	_ = prReviewCommentsCmd.MarkFlagRequired("repo")
+	return prReviewCommentsCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrReviewCommentsCmd())
+}
@@ -0,0 +1,157 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// latestReviews returns the review that counts towards the review decision
+// for every reviewer, in the order the reviewers first reviewed. Comments
+// don't replace an earlier approval or request for changes, and pending
+// reviews haven't been submitted yet, so neither counts.
+func latestReviews(reviews []*github.PullRequestReview) []*github.PullRequestReview {
+	var order []string
+	latest := make(map[string]*github.PullRequestReview)
+	for _, review := range reviews {
+		login := review.GetUser().GetLogin()
+		if review.GetState() == "PENDING" {
+			continue
+		}
+		previous, ok := latest[login]
+		if !ok {
+			order = append(order, login)
+		}
+		if ok && review.GetState() == "COMMENTED" && previous.GetState() != "COMMENTED" {
+			continue
+		}
+		latest[login] = review
+	}
+	result := make([]*github.PullRequestReview, 0, len(order))
+	for _, login := range order {
+		result = append(result, latest[login])
+	}
+	return result
+}
+
+// reviewDecision works out the review decision for the latest reviews of a
+// PR the way branch protection does. required is the number of approvals
+// branch protection requires, 0 if it doesn't require any.
+func reviewDecision(latest []*github.PullRequestReview, required int) string {
+	approvals := 0
+	for _, review := range latest {
+		switch review.GetState() {
+		case "CHANGES_REQUESTED":
+			return "CHANGES_REQUESTED"
+		case "APPROVED":
+			approvals++
+		}
+	}
+	switch {
+	case required == 0 && approvals == 0:
+		return "NONE"
+	case approvals >= required:
+		return "APPROVED"
+	}
+	return "REVIEW_REQUIRED"
+}
+
+func reviewColour(state string) string {
+	switch state {
+	case "APPROVED":
+		return green
+	case "CHANGES_REQUESTED":
+		return red
+	case "REVIEW_REQUIRED":
+		return yellow
+	}
+	return reset
+}
+
+// requiredApprovals returns the number of approvals the protection of branch
+// requires. Reading the protection needs admin access, so without it one
+// approval is assumed.
+func requiredApprovals(cmd *cobra.Command, ghApi *api.API, repo, branch string) int {
+	protection, err := ghApi.GetBranchProtection(repo, branch)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: can't read the protection of %s, assuming one approval is required\n", branch)
+		return 1
+	}
+	if protection == nil || protection.RequiredPullRequestReviews == nil {
+		return 0
+	}
+	return protection.RequiredPullRequestReviews.RequiredApprovingReviewCount
+}
+
+func newPrReviewsCmd() *cobra.Command {
+	var repo string
+	var prReviewsCmd = &cobra.Command{
+		Use:   "reviews <number>",
+		Short: "List the reviews of a PR",
+		Long: `List the latest review of every reviewer of a PR and the review decision,
+taking the number of approvals required by the protection of the base branch
+into account.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			pr, err := ghApi.GetPR(repo, args[0])
+			if err != nil {
+				return err
+			}
+			reviews, err := ghApi.ListReviews(repo, number)
+			if err != nil {
+				return err
+			}
+			required := requiredApprovals(cmd, ghApi, repo, pr.GetBase().GetRef())
+			latest := latestReviews(reviews)
+
+			out := cmd.OutOrStdout()
+			for _, review := range latest {
+				fmt.Fprintf(out, "%s%s: %s at %s\n", reviewColour(review.GetState()), review.GetUser().GetLogin(),
+					review.GetState(), review.GetSubmittedAt().Format("2006-01-02 15:04"))
+			}
+			decision := reviewDecision(latest, required)
+			fmt.Fprintf(out, "%sDecision: %s\n", reviewColour(decision), decision)
+			fmt.Fprintf(out, "%sRequired approvals: %d\n", reset, required)
+			return nil
+		},
+	}
+	prReviewsCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	_ = prReviewsCmd.MarkFlagRequired("repo")
+	return prReviewsCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrReviewsCmd())
+}
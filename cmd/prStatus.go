@@ -48,15 +48,7 @@ func newPrStatusCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			statusColour := green
-			if *pr.State == "closed" {
-				statusColour = red
-			}
-			fmt.Fprintf(cmd.OutOrStdout(), "%sStatus: %s\n", statusColour, *pr.State)
-			fmt.Fprintf(cmd.OutOrStdout(), "%sTitle: %s\n", statusColour, *pr.Title)
-			fmt.Fprintf(cmd.OutOrStdout(), "%sURL: %s\n", statusColour, *pr.HTMLURL)
-			fmt.Fprintf(cmd.OutOrStdout(), "%sNumber: %d\n", statusColour, *pr.Number)
-			fmt.Fprintf(cmd.OutOrStdout(), "%sBody: %s\n", statusColour, *pr.Body)
+			printPR(cmd.OutOrStdout(), pr)
 			return nil
 		},
 	}
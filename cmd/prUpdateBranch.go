@@ -0,0 +1,79 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newPrUpdateBranchCmd() *cobra.Command {
+	var repo string
+	var rebase bool
+	var prUpdateBranchCmd = &cobra.Command{
+		Use:   "update-branch <number>",
+		Short: "Bring a PR up to date with its base branch",
+		Long: `Merge the base branch of a PR into its head branch on GitHub, or rebase the
+head branch onto it with --rebase. The update is refused if commits are pushed
+to the PR in the meantime.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			number, err := parsePRNumber(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			pr, err := ghApi.GetPR(repo, args[0])
+			if err != nil {
+				return err
+			}
+			if pr.GetState() != "open" {
+				return fmt.Errorf("#%d is %s", number, pr.GetState())
+			}
+			head := pr.GetHead().GetSHA()
+			if rebase {
+				err = ghApi.RebasePRBranch(pr.GetNodeID(), head)
+			} else {
+				err = ghApi.UpdatePRBranch(repo, number, head)
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sUpdating #%d with the changes of %s\n", green, number, pr.GetBase().GetRef())
+			return nil
+		},
+	}
+	prUpdateBranchCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the PR belongs to")
+	prUpdateBranchCmd.Flags().BoolVar(&rebase, "rebase", false, "rebase the head branch instead of merging the base branch into it")
+	_ = prUpdateBranchCmd.MarkFlagRequired("repo")
+	return prUpdateBranchCmd
+}
+
+func init() {
+	prCmd.AddCommand(newPrUpdateBranchCmd())
+}
@@ -0,0 +1,997 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/github"
+	"github.com/tjgurwara99/ghcli/api"
+	"github.com/tjgurwara99/ghcli/git"
+)
+
+// newTestCheckout creates a clone of a bare repository with a single commit
+// on main and points gitClient at it for the duration of the test. It returns
+// the path of the clone.
+func newTestCheckout(t *testing.T) string {
+	t.Helper()
+	t.Setenv("GIT_AUTHOR_NAME", "Test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+	dir := t.TempDir()
+	origin := filepath.Join(dir, "origin.git")
+	clone := filepath.Join(dir, "clone")
+	runGit(t, dir, "init", "--bare", "-b", "main", origin)
+	runGit(t, dir, "clone", origin, clone)
+	runGit(t, clone, "checkout", "-b", "main")
+	runGit(t, clone, "commit", "--allow-empty", "-m", "Initial commit")
+	runGit(t, clone, "push", "-u", "origin", "main")
+	oldGitClient := gitClient
+	t.Cleanup(func() { gitClient = oldGitClient })
+	gitClient = git.New(clone)
+	return clone
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+	return string(bytes.TrimSpace(out))
+}
+
+func TestFillFromCommits(t *testing.T) {
+	title, body := fillFromCommits("heap-sort", []git.Commit{{Subject: "Add heap sort", Body: "It's quick."}})
+	if title != "Add heap sort" || body != "It's quick." {
+		t.Errorf("fillFromCommits() with one commit = %q, %q", title, body)
+	}
+	title, body = fillFromCommits("heap_sort-fixes", []git.Commit{{Subject: "Add heap sort"}, {Subject: "Fix typo"}})
+	if title != "Heap sort fixes" || body != "- Add heap sort\n- Fix typo" {
+		t.Errorf("fillFromCommits() with two commits = %q, %q", title, body)
+	}
+}
+
+func TestPrCreateCmd(t *testing.T) {
+	dir := newTestCheckout(t)
+	runGit(t, dir, "checkout", "-b", "heap-sort")
+	if err := os.MkdirAll(filepath.Join(dir, ".github"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".github", "pull_request_template.md"), []byte("## Checklist"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, dir, "add", ".")
+	runGit(t, dir, "commit", "-m", "Add heap sort", "-m", "It's quick.")
+
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	var created map[string]interface{}
+	client = newTestClient(func(req *http.Request) *http.Response {
+		status := 200
+		body := `{}`
+		switch req.Method + " " + req.URL.String() {
+		case "GET https://api.github.com/repos/TheAlgorithms/Go":
+			body = `{"default_branch": "main"}`
+		case "GET https://api.github.com/repos/TheAlgorithms/Go/pulls?head=TheAlgorithms%3Aheap-sort&state=open":
+			body = `[]`
+		case "POST https://api.github.com/repos/TheAlgorithms/Go/pulls":
+			if err := json.NewDecoder(req.Body).Decode(&created); err != nil {
+				t.Errorf("decoding PR: %v", err)
+			}
+			status = 201
+			body = `{"number": 5, "html_url": "https://sample.url"}`
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"pr", "create", "-r", "TheAlgorithms/Go", "--fill", "--draft"})
+	err := rootCmd.Execute()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := map[string]interface{}{
+		"title": "Add heap sort",
+		"head":  "heap-sort",
+		"base":  "main",
+		"body":  "It's quick.\n\n## Checklist",
+		"draft": true,
+	}
+	for key, value := range want {
+		if created[key] != value {
+			t.Errorf("created PR %s = %q, want %q", key, created[key], value)
+		}
+	}
+	if pushed := runGit(t, dir, "rev-parse", "origin/heap-sort"); pushed != runGit(t, dir, "rev-parse", "HEAD") {
+		t.Errorf("the branch wasn't pushed")
+	}
+	got := buff.String()
+	wantOut := "Pushing heap-sort to origin\n\x1b[32mCreated PR #5\n\x1b[32mURL: https://sample.url\n"
+	if got != wantOut {
+		t.Errorf("got %q, want %q", got, wantOut)
+	}
+}
+
+func TestPrCheckoutCmd(t *testing.T) {
+	dir := newTestCheckout(t)
+	runGit(t, dir, "checkout", "-b", "heap-sort")
+	runGit(t, dir, "commit", "--allow-empty", "-m", "Add heap sort")
+	runGit(t, dir, "push", "origin", "heap-sort")
+	head := runGit(t, dir, "rev-parse", "HEAD")
+	runGit(t, dir, "checkout", "main")
+	runGit(t, dir, "branch", "-D", "heap-sort")
+
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/pulls/5" {
+			t.Errorf("unexpected URL %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body: ioutil.NopCloser(bytes.NewBufferString(`{
+	"number": 5,
+	"head": {"ref": "heap-sort", "repo": {"full_name": "TheAlgorithms/Go"}}
+}`)),
+			Header: make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"pr", "checkout", "5", "-r", "TheAlgorithms/Go"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := runGit(t, dir, "rev-parse", "--abbrev-ref", "HEAD"); got != "heap-sort" {
+		t.Errorf("checked out branch = %q, want heap-sort", got)
+	}
+	if got := runGit(t, dir, "rev-parse", "HEAD"); got != head {
+		t.Errorf("HEAD = %q, want %q", got, head)
+	}
+	if got := runGit(t, dir, "rev-parse", "--abbrev-ref", "@{upstream}"); got != "origin/heap-sort" {
+		t.Errorf("upstream = %q, want origin/heap-sort", got)
+	}
+	if got, want := buff.String(), "\x1b[32mChecked out #5 as heap-sort\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	// Checking out again with local changes is refused.
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("wip"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, dir, "add", "notes.txt")
+	rootCmd.SetArgs([]string{"pr", "checkout", "5", "-r", "TheAlgorithms/Go"})
+	err := rootCmd.Execute()
+	if err == nil || err.Error() != "you have uncommitted changes - commit or stash them before checking out #5" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestPrDiffCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.String() != "https://api.github.com/repos/TheAlgorithms/Go/pulls/5" {
+			t.Errorf("unexpected URL %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("--- a/a.go\n+++ b/a.go\n@@ -1 +1 @@\n-old\n+new\n")),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"pr", "diff", "5", "-r", "TheAlgorithms/Go", "--color", "always"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := "--- a/a.go\n+++ b/a.go\n\x1b[33m@@ -1 +1 @@\x1b[0m\n\x1b[31m-old\x1b[0m\n\x1b[32m+new\x1b[0m\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPrFilesCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	errBuff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		body := `{"number": 5, "changed_files": 3}`
+		if req.URL.Path == "/repos/TheAlgorithms/Go/pulls/5/files" {
+			body = `[
+	{"filename": "sort/heap.go", "status": "added", "additions": 40, "deletions": 0},
+	{"filename": "README.md", "status": "modified", "additions": 1, "deletions": 1}
+]`
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetErr(errBuff)
+	defer rootCmd.SetErr(nil)
+	rootCmd.SetArgs([]string{"pr", "files", "5", "-r", "TheAlgorithms/Go"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := "added     +40  -0  sort/heap.go\nmodified  +1   -1  README.md\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := errBuff.String(), "Warning: GitHub only lists 2 of the 3 files changed by #5\n"; got != want {
+		t.Errorf("got warning %q, want %q", got, want)
+	}
+}
+
+func TestWriteDiffStat(t *testing.T) {
+	buff := new(bytes.Buffer)
+	writeDiffStat(buff, []*github.CommitFile{
+		{Filename: github.String("sort/heap.go"), Additions: github.Int(100), Deletions: github.Int(0), Changes: github.Int(100)},
+		{Filename: github.String("README.md"), Additions: github.Int(2), Deletions: github.Int(2), Changes: github.Int(4)},
+	}, false)
+	want := " sort/heap.go | 100 " + strings.Repeat("+", 50) + "\n" +
+		" README.md    | 4 +-\n" +
+		" 2 files changed, 102 insertions(+), 2 deletions(-)\n"
+	if got := buff.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestMergeBlocker(t *testing.T) {
+	tests := []struct {
+		name   string
+		pr     string
+		checks []prCheck
+		auto   bool
+		want   string
+	}{
+		{"clean", `{"state": "open", "mergeable": true, "mergeable_state": "clean"}`, []prCheck{{name: "build", state: "pass"}}, false, ""},
+		{"no checks", `{"state": "open", "mergeable": true, "mergeable_state": "clean"}`, nil, false, ""},
+		{"skipped checks", `{"state": "open", "mergeable": true, "mergeable_state": "clean"}`, []prCheck{{name: "lint", state: "skipping"}}, false, ""},
+		{"conflicts", `{"state": "open", "mergeable": false, "mergeable_state": "dirty", "base": {"ref": "main"}}`, nil, true, "it has conflicts with main"},
+		{"computing", `{"state": "open", "mergeable_state": "unknown"}`, nil, false, "GitHub is still working out whether it can be merged - try again in a moment"},
+		{"failing", `{"state": "open", "mergeable": true, "mergeable_state": "unstable"}`,
+			[]prCheck{{name: "ci/test", state: "fail"}, {name: "lint", state: "pending"}, {name: "ci/build", state: "pass"}},
+			false, "its checks haven't passed: ci/test (fail), lint (pending)"},
+		{"failing with auto", `{"state": "open", "mergeable": true, "mergeable_state": "unstable"}`, []prCheck{{name: "ci/test", state: "fail"}}, true, ""},
+		{"missing reviews", `{"state": "open", "mergeable": true, "mergeable_state": "blocked"}`, nil, false,
+			"it is blocked by branch protection - required reviews or checks are missing"},
+		{"missing reviews with auto", `{"state": "open", "mergeable": true, "mergeable_state": "blocked"}`, nil, true, ""},
+		{"merged", `{"state": "closed", "merged": true}`, nil, false, "it is already merged"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pr github.PullRequest
+			if err := json.Unmarshal([]byte(tt.pr), &pr); err != nil {
+				t.Fatal(err)
+			}
+			if got := mergeBlocker(&pr, tt.checks, tt.auto); got != tt.want {
+				t.Errorf("mergeBlocker() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrMergeCmd(t *testing.T) {
+	dir := newTestCheckout(t)
+	runGit(t, dir, "checkout", "-b", "heap-sort")
+	sha := runGit(t, dir, "rev-parse", "HEAD")
+
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	var requests []string
+	client = newTestClient(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		status := 200
+		body := `{}`
+		switch req.Method + " " + req.URL.Path {
+		case "GET /repos/TheAlgorithms/Go/pulls/5":
+			body = `{"number": 5, "state": "open", "mergeable": true, "mergeable_state": "clean",
+				"head": {"ref": "heap-sort", "sha": "` + sha + `", "repo": {"full_name": "TheAlgorithms/Go"}},
+				"base": {"ref": "main"}}`
+		case "GET /repos/TheAlgorithms/Go/commits/" + sha + "/check-runs":
+			body = `{"total_count": 1, "check_runs": [{"name": "build", "status": "completed", "conclusion": "success"}]}`
+		case "GET /repos/TheAlgorithms/Go/commits/" + sha + "/status":
+			body = `{"state": "success", "total_count": 1}`
+		case "PUT /repos/TheAlgorithms/Go/pulls/5/merge":
+			body = `{"sha": "def", "merged": true}`
+		case "DELETE /repos/TheAlgorithms/Go/git/refs/heads/heap-sort":
+			status = 204
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"pr", "merge", "5", "-r", "TheAlgorithms/Go", "--squash", "--delete-branch", "--auto=false", "--match-head-commit", sha})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(requests) != 5 {
+		t.Errorf("got requests %v", requests)
+	}
+	if got := runGit(t, dir, "rev-parse", "--abbrev-ref", "HEAD"); got != "main" {
+		t.Errorf("checked out branch = %q, want main", got)
+	}
+	got := buff.String()
+	want := "\x1b[32mMerged #5 as def\n\x1b[32mDeleted branch heap-sort of TheAlgorithms/Go\n\x1b[32mDeleted local branch heap-sort\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPrMergeCmdFailingCheckRun(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		body := `{}`
+		switch req.Method + " " + req.URL.Path {
+		case "GET /repos/TheAlgorithms/Go/pulls/6":
+			body = `{"number": 6, "state": "open", "mergeable": true, "mergeable_state": "unstable",
+				"head": {"ref": "quick-sort", "sha": "abc"}, "base": {"ref": "main"}}`
+		case "GET /repos/TheAlgorithms/Go/commits/abc/check-runs":
+			body = `{"total_count": 1, "check_runs": [{"name": "test", "status": "completed", "conclusion": "failure"}]}`
+		case "GET /repos/TheAlgorithms/Go/commits/abc/status":
+			body = `{"state": "pending", "total_count": 0, "statuses": []}`
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"pr", "merge", "6", "-r", "TheAlgorithms/Go", "--auto=false", "--squash=false", "--delete-branch=false", "--match-head-commit", ""})
+	err := rootCmd.Execute()
+	want := "can't merge #6: its checks haven't passed: test (fail)"
+	if err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+}
+
+func TestPrMergeCmdAuto(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    string
+		requests []string
+		want     string
+	}{
+		{"blocked", "blocked", []string{"GET /repos/TheAlgorithms/Go/pulls/7", "POST /graphql"}, "\x1b[32mAuto-merge enabled for #7\n"},
+		{"clean", "clean", []string{
+			"GET /repos/TheAlgorithms/Go/pulls/7",
+			"GET /repos/TheAlgorithms/Go/commits/abc/check-runs",
+			"GET /repos/TheAlgorithms/Go/commits/abc/status",
+			"PUT /repos/TheAlgorithms/Go/pulls/7/merge",
+		}, "#7 can already be merged, merging it now\n\x1b[32mMerged #7 as def\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buff := new(bytes.Buffer)
+			oldClient := client
+			defer func() { client = oldClient }()
+			var requests []string
+			client = newTestClient(func(req *http.Request) *http.Response {
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				body := `{}`
+				switch req.Method + " " + req.URL.Path {
+				case "GET /repos/TheAlgorithms/Go/pulls/7":
+					body = `{"number": 7, "node_id": "PR_7", "state": "open", "mergeable": true, "mergeable_state": "` + tt.state + `",
+						"head": {"ref": "merge-sort", "sha": "abc"}, "base": {"ref": "main"}}`
+				case "POST /graphql":
+					body = `{"data": {"enablePullRequestAutoMerge": {"clientMutationId": null}}}`
+				case "GET /repos/TheAlgorithms/Go/commits/abc/check-runs":
+					body = `{"total_count": 0, "check_runs": []}`
+				case "GET /repos/TheAlgorithms/Go/commits/abc/status":
+					body = `{"state": "success", "total_count": 1, "statuses": [{"context": "ci/test", "state": "success"}]}`
+				case "PUT /repos/TheAlgorithms/Go/pulls/7/merge":
+					body = `{"sha": "def", "merged": true}`
+				default:
+					t.Errorf("unexpected request %v %v", req.Method, req.URL)
+				}
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+					Header:     make(http.Header),
+				}
+			})
+			rootCmd.SetOut(buff)
+			rootCmd.SetArgs([]string{"pr", "merge", "7", "-r", "TheAlgorithms/Go", "--auto", "--squash=false", "--delete-branch=false", "--match-head-commit", ""})
+			if err := rootCmd.Execute(); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(requests, tt.requests) {
+				t.Errorf("got requests %v, want %v", requests, tt.requests)
+			}
+			if got := buff.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeletePRBranchKeepsOtherBranches(t *testing.T) {
+	dir := newTestCheckout(t)
+	runGit(t, dir, "checkout", "-b", "heap-sort")
+	runGit(t, dir, "commit", "--allow-empty", "-m", "Unpushed work")
+	runGit(t, dir, "checkout", "main")
+	pr := func(ref string) *github.PullRequest {
+		return &github.PullRequest{
+			Number: github.Int(5),
+			Head:   &github.PullRequestBranch{Ref: github.String(ref), SHA: github.String("abc"), Repo: &github.Repository{FullName: github.String("octocat/Go")}},
+			Base:   &github.PullRequestBranch{Ref: github.String("main"), Repo: &github.Repository{FullName: github.String("TheAlgorithms/Go")}},
+		}
+	}
+	buff := new(bytes.Buffer)
+	errBuff := new(bytes.Buffer)
+	cmd := newPrMergeCmd()
+	cmd.SetOut(buff)
+	cmd.SetErr(errBuff)
+	// Neither branch tracks the head of the PR in the fork or points at its
+	// head commit.
+	for _, branch := range []string{"main", "heap-sort"} {
+		buff.Reset()
+		if err := deletePRBranch(cmd, nil, "TheAlgorithms/Go", pr(branch), true); err != nil {
+			t.Fatalf("deletePRBranch(%s) error = %v", branch, err)
+		}
+		want := "Not deleting " + branch + ", it belongs to octocat/Go\nNot deleting local branch " + branch + ", it isn't the head of #5\n"
+		if buff.String() != want {
+			t.Errorf("deletePRBranch(%s) printed %q, want %q", branch, buff.String(), want)
+		}
+		if !gitClient.BranchExists(branch) {
+			t.Errorf("deletePRBranch() deleted local branch %s", branch)
+		}
+	}
+	// heap-sort tracks the head of the PR, but git refuses to delete it as
+	// its last commit isn't merged.
+	runGit(t, dir, "config", "branch.heap-sort.remote", "https://github.com/octocat/Go.git")
+	runGit(t, dir, "config", "branch.heap-sort.merge", "refs/heads/heap-sort")
+	if err := deletePRBranch(cmd, nil, "TheAlgorithms/Go", pr("heap-sort"), true); err != nil {
+		t.Fatalf("deletePRBranch() error = %v", err)
+	}
+	if !gitClient.BranchExists("heap-sort") {
+		t.Errorf("deletePRBranch() deleted unmerged local branch heap-sort")
+	}
+	if !strings.HasPrefix(errBuff.String(), "Warning: not deleting local branch heap-sort") {
+		t.Errorf("deletePRBranch() warned %q", errBuff.String())
+	}
+}
+
+func TestReviewDecision(t *testing.T) {
+	review := func(login, state string) *github.PullRequestReview {
+		return &github.PullRequestReview{User: &github.User{Login: &login}, State: &state}
+	}
+	reviews := []*github.PullRequestReview{
+		review("alice", "APPROVED"),
+		review("bob", "CHANGES_REQUESTED"),
+		review("alice", "COMMENTED"),
+		review("bob", "APPROVED"),
+		review("carol", "COMMENTED"),
+		review("dave", "PENDING"),
+	}
+	latest := latestReviews(reviews)
+	var got []string
+	for _, r := range latest {
+		got = append(got, r.GetUser().GetLogin()+" "+r.GetState())
+	}
+	want := []string{"alice APPROVED", "bob APPROVED", "carol COMMENTED"}
+	if strings.Join(got, ", ") != strings.Join(want, ", ") {
+		t.Errorf("latestReviews() = %v, want %v", got, want)
+	}
+	tests := []struct {
+		reviews  []*github.PullRequestReview
+		required int
+		want     string
+	}{
+		{nil, 0, "NONE"},
+		{nil, 1, "REVIEW_REQUIRED"},
+		{latest, 2, "APPROVED"},
+		{latest, 3, "REVIEW_REQUIRED"},
+		{append(latest, review("erin", "CHANGES_REQUESTED")), 1, "CHANGES_REQUESTED"},
+		{[]*github.PullRequestReview{review("alice", "APPROVED")}, 0, "APPROVED"},
+	}
+	for _, tt := range tests {
+		if got := reviewDecision(tt.reviews, tt.required); got != tt.want {
+			t.Errorf("reviewDecision(%d reviews, %d) = %q, want %q", len(tt.reviews), tt.required, got, tt.want)
+		}
+	}
+}
+
+func TestPrReviewsCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		status := 200
+		body := `{}`
+		switch req.URL.Path {
+		case "/repos/TheAlgorithms/Go/pulls/5":
+			body = `{"number": 5, "base": {"ref": "main"}}`
+		case "/repos/TheAlgorithms/Go/pulls/5/reviews":
+			body = `[{"user": {"login": "alice"}, "state": "APPROVED", "submitted_at": "2022-05-01T10:00:00Z"},
+				{"user": {"login": "bob"}, "state": "COMMENTED", "submitted_at": "2022-05-02T11:30:00Z"}]`
+		case "/repos/TheAlgorithms/Go/branches/main/protection":
+			body = `{"required_pull_request_reviews": {"required_approving_review_count": 2}}`
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"pr", "reviews", "5", "-r", "TheAlgorithms/Go"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := "\x1b[32malice: APPROVED at 2022-05-01 10:00\n" +
+		"\x1b[0mbob: COMMENTED at 2022-05-02 11:30\n" +
+		"\x1b[33mDecision: REVIEW_REQUIRED\n" +
+		"\x1b[0mRequired approvals: 2\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestRequiredApprovals(t *testing.T) {
+	tests := []struct {
+		body string
+		want int
+	}{
+		{`{"required_pull_request_reviews": {"required_approving_review_count": 0}}`, 0},
+		{`{"required_pull_request_reviews": {"required_approving_review_count": 3}}`, 3},
+		{`{"required_status_checks": {"strict": true, "contexts": []}}`, 0},
+	}
+	for _, tt := range tests {
+		ghApi := api.NewApi(newTestClient(func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(tt.body)),
+				Header:     make(http.Header),
+			}
+		}))
+		if got := requiredApprovals(newPrReviewsCmd(), ghApi, "TheAlgorithms/Go", "main"); got != tt.want {
+			t.Errorf("requiredApprovals() of %s = %d, want %d", tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestPrReviewCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	var event string
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if req.Method == "GET" && req.URL.Path == "/repos/TheAlgorithms/Go/pulls/5/reviews" {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`[{"id": 1, "state": "COMMENTED"}, {"id": 7, "state": "PENDING"}]`)),
+				Header:     make(http.Header),
+			}
+		}
+		if req.Method != "POST" || req.URL.Path != "/repos/TheAlgorithms/Go/pulls/5/reviews/7/events" {
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		var review map[string]string
+		if err := json.NewDecoder(req.Body).Decode(&review); err != nil {
+			t.Fatal(err)
+		}
+		event = review["event"]
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"html_url": "https://github.com/TheAlgorithms/Go/pull/5#pullrequestreview-1"}`)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"pr", "review", "5", "-r", "TheAlgorithms/Go", "--approve"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if event != "APPROVE" {
+		t.Errorf("review event = %q, want APPROVE", event)
+	}
+	got := buff.String()
+	want := "\x1b[32mApproved #5\n\x1b[0mURL: https://github.com/TheAlgorithms/Go/pull/5#pullrequestreview-1\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPrReviewCommentsCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/graphql" {
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		body := `{"data": {"repository": {"pullRequest": {"reviewThreads": {"nodes": [
+			{"path": "sort/heap.go", "line": 12, "isResolved": false, "comments": {"nodes": [
+				{"author": {"login": "alice"}, "body": "Off by one?", "createdAt": "2022-05-01T10:00:00Z",
+				 "diffHunk": "@@ -1,5 +1,6 @@\n a\n b\n c\n-d\n+e"},
+				{"author": {"login": "bob"}, "body": "Fixed", "createdAt": "2022-05-01T11:00:00Z"}]}},
+			{"path": "README.md", "line": 3, "isResolved": true, "isOutdated": true, "comments": {"nodes": [
+				{"author": {"login": "alice"}, "body": "Typo", "createdAt": "2022-05-02T09:00:00Z", "diffHunk": "@@ -3 +3 @@\n+heap sort"}]}}
+		]}}}}}`
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"pr", "review-comments", "5", "-r", "TheAlgorithms/Go"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := "\x1b[0mREADME.md\n" +
+		"\x1b[32m  Line 3 (resolved, outdated)\n" +
+		"    \x1b[33m@@ -3 +3 @@\x1b[0m\n" +
+		"    \x1b[32m+heap sort\x1b[0m\n" +
+		"\x1b[0m    alice (2022-05-02 09:00): Typo\n" +
+		"\x1b[0msort/heap.go\n" +
+		"\x1b[33m  Line 12 (open)\n" +
+		"     b\n" +
+		"     c\n" +
+		"    \x1b[31m-d\x1b[0m\n" +
+		"    \x1b[32m+e\x1b[0m\n" +
+		"\x1b[0m    alice (2022-05-01 10:00): Off by one?\n" +
+		"\x1b[0m    bob (2022-05-01 11:00): Fixed\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPrChecksCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	errBuff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	polls := 0
+	client = newTestClient(func(req *http.Request) *http.Response {
+		body := `{}`
+		switch req.URL.Path {
+		case "/repos/TheAlgorithms/Go/pulls/5":
+			body = `{"number": 5, "head": {"sha": "abc"}}`
+		case "/repos/TheAlgorithms/Go/commits/abc/check-runs":
+			polls++
+			body = `{"check_runs": [{"name": "build", "status": "in_progress", "started_at": "2022-05-01T10:00:00Z"},
+				{"name": "lint", "status": "completed", "conclusion": "skipped"}]}`
+			if polls > 1 {
+				body = `{"check_runs": [{"name": "build", "status": "completed", "conclusion": "failure",
+					"started_at": "2022-05-01T10:00:00Z", "completed_at": "2022-05-01T10:01:30Z",
+					"html_url": "https://github.com/TheAlgorithms/Go/runs/1"},
+					{"name": "lint", "status": "completed", "conclusion": "skipped"}]}`
+			}
+		case "/repos/TheAlgorithms/Go/commits/abc/status":
+			body = `{"state": "success", "total_count": 1, "statuses": [{"context": "ci/circleci", "state": "success",
+				"target_url": "https://circleci.com/1", "created_at": "2022-05-01T10:00:00Z", "updated_at": "2022-05-01T10:00:42Z"}]}`
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetErr(errBuff)
+	defer rootCmd.SetErr(nil)
+	oldSleep := sleep
+	defer func() { sleep = oldSleep }()
+	sleep = func(time.Duration) {}
+	rootCmd.SetArgs([]string{"pr", "checks", "5", "-r", "TheAlgorithms/Go", "--watch", "--interval", "0s"})
+	err := rootCmd.Execute()
+	if err == nil || err.Error() != "--interval has to be at least 1s" || polls != 0 {
+		t.Fatalf("watching with a zero interval = %v after %d polls", err, polls)
+	}
+	buff.Reset()
+	rootCmd.SetArgs([]string{"pr", "checks", "5", "-r", "TheAlgorithms/Go", "--watch", "--interval", "1s"})
+	err = rootCmd.Execute()
+	if err == nil || err.Error() != "1 of 3 checks failed" {
+		t.Errorf("got error %v, want 1 of 3 checks failed", err)
+	}
+	if polls != 2 {
+		t.Errorf("polled %d times, want 2", polls)
+	}
+	if !strings.Contains(errBuff.String(), "Waiting for 1 of 3 checks to complete\n") {
+		t.Errorf("got %q, want a waiting message", errBuff.String())
+	}
+	got := buff.String()
+	want := "\x1b[31mfail      build        1m30s  https://github.com/TheAlgorithms/Go/runs/1\x1b[0m\n" +
+		"\x1b[32mpass      ci/circleci  42s    https://circleci.com/1\x1b[0m\n" +
+		"\x1b[37mskipping  lint                \x1b[0m\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPrEditCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	var requests []string
+	client = newTestClient(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		status := 200
+		body := `{}`
+		switch req.Method + " " + req.URL.Path {
+		case "PATCH /repos/TheAlgorithms/Go/pulls/5":
+			var edit map[string]interface{}
+			if err := json.NewDecoder(req.Body).Decode(&edit); err != nil {
+				t.Fatal(err)
+			}
+			if want := map[string]interface{}{"title": "Add heap sort", "base": "dev"}; !reflect.DeepEqual(edit, want) {
+				t.Errorf("edit = %v, want %v", edit, want)
+			}
+		case "POST /repos/TheAlgorithms/Go/pulls/5/requested_reviewers":
+			status = 201
+		case "DELETE /repos/TheAlgorithms/Go/pulls/5/requested_reviewers":
+		case "POST /repos/TheAlgorithms/Go/issues/5/labels":
+			body = `[{"name": "enhancement"}]`
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"pr", "edit", "5", "-r", "TheAlgorithms/Go", "--title", "Add heap sort", "--base", "dev",
+		"--add-reviewer", "octocat,TheAlgorithms/maintainers", "--remove-reviewer", "hubot", "--add-label", "enhancement"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(requests) != 4 {
+		t.Errorf("got requests %v", requests)
+	}
+	got := buff.String()
+	want := "\x1b[32mEdited #5\n" +
+		"\x1b[32mRequested reviews from octocat, TheAlgorithms/maintainers\n" +
+		"\x1b[32mWithdrew review requests from hubot\n" +
+		"\x1b[32mAdded labels enhancement\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPrReadyCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	var mutation string
+	client = newTestClient(func(req *http.Request) *http.Response {
+		body := `{"data": {}}`
+		switch req.URL.Path {
+		case "/repos/TheAlgorithms/Go/pulls/5":
+			body = `{"number": 5, "state": "open", "node_id": "PR_1"}`
+		case "/graphql":
+			b, _ := ioutil.ReadAll(req.Body)
+			mutation = string(b)
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"pr", "ready", "5", "-r", "TheAlgorithms/Go"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(mutation, "markPullRequestReadyForReview") || !strings.Contains(mutation, "PR_1") {
+		t.Errorf("got mutation %s", mutation)
+	}
+	if got, want := buff.String(), "\x1b[32mMarked #5 as ready for review\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPrCloseCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	var requests []string
+	client = newTestClient(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		status := 200
+		body := `{}`
+		switch req.Method + " " + req.URL.Path {
+		case "GET /repos/TheAlgorithms/Go/pulls/5":
+			body = `{"number": 5, "state": "open"}`
+		case "POST /repos/TheAlgorithms/Go/issues/5/comments":
+			status = 201
+		case "PATCH /repos/TheAlgorithms/Go/pulls/5":
+			b, _ := ioutil.ReadAll(req.Body)
+			if string(b) != `{"state":"closed"}`+"\n" {
+				t.Errorf("got edit %s", b)
+			}
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"pr", "close", "5", "-r", "TheAlgorithms/Go", "--comment", "Superseded by #6", "--delete-branch=false"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(requests) != 3 || requests[1] != "POST /repos/TheAlgorithms/Go/issues/5/comments" {
+		t.Errorf("got requests %v", requests)
+	}
+	if got, want := buff.String(), "\x1b[31mClosed #5\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPrCloseCmdDeleteBranch(t *testing.T) {
+	dir := newTestCheckout(t)
+	runGit(t, dir, "checkout", "-b", "heap-sort")
+	runGit(t, dir, "commit", "--allow-empty", "-m", "Add heap sort")
+	runGit(t, dir, "push", "-u", "origin", "heap-sort")
+	sha := runGit(t, dir, "rev-parse", "HEAD")
+
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	var requests []string
+	client = newTestClient(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		status := 200
+		body := `{}`
+		switch req.Method + " " + req.URL.Path {
+		case "GET /repos/TheAlgorithms/Go/pulls/5":
+			body = `{"number": 5, "state": "open",
+				"head": {"ref": "heap-sort", "sha": "` + sha + `", "repo": {"full_name": "TheAlgorithms/Go"}},
+				"base": {"ref": "main", "repo": {"full_name": "TheAlgorithms/Go"}}}`
+		case "PATCH /repos/TheAlgorithms/Go/pulls/5":
+		case "DELETE /repos/TheAlgorithms/Go/git/refs/heads/heap-sort":
+			status = 204
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	defer rootCmd.SetIn(nil)
+	rootCmd.SetOut(buff)
+	rootCmd.SetIn(strings.NewReader("n\n"))
+	rootCmd.SetArgs([]string{"pr", "close", "5", "-r", "TheAlgorithms/Go", "--comment", "", "--delete-branch"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(requests) != 1 {
+		t.Errorf("declining to delete the branch made requests %v", requests)
+	}
+
+	buff.Reset()
+	rootCmd.SetIn(strings.NewReader("y\n"))
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := "#5 isn't merged, delete branch heap-sort of TheAlgorithms/Go and any commits only it has? [y/N] " +
+		"\x1b[31mClosed #5\n\x1b[32mDeleted branch heap-sort of TheAlgorithms/Go\n" +
+		"Not deleting local branch heap-sort, #5 isn't merged\n"
+	if got := buff.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := runGit(t, dir, "rev-parse", "heap-sort"); got != sha {
+		t.Errorf("local branch heap-sort = %q, want the unmerged commit %s", got, sha)
+	}
+}
+
+func TestPrUpdateBranchCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	var update string
+	client = newTestClient(func(req *http.Request) *http.Response {
+		status := 200
+		body := `{}`
+		switch req.Method + " " + req.URL.Path {
+		case "GET /repos/TheAlgorithms/Go/pulls/5":
+			body = `{"number": 5, "state": "open", "head": {"sha": "abc"}, "base": {"ref": "main"}}`
+		case "PUT /repos/TheAlgorithms/Go/pulls/5/update-branch":
+			b, _ := ioutil.ReadAll(req.Body)
+			update = string(b)
+			status = 202
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"pr", "update-branch", "5", "-r", "TheAlgorithms/Go"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if update != `{"expected_head_sha":"abc"}`+"\n" {
+		t.Errorf("got update %q", update)
+	}
+	if got, want := buff.String(), "\x1b[32mUpdating #5 with the changes of main\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPrCommitsCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/repos/TheAlgorithms/Go/pulls/5/commits" {
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		body := `[{"sha": "0123456789abcdef", "author": {"login": "octocat"},
+			"commit": {"message": "Add heap sort\n\nIt's quick.", "author": {"name": "Mona"}, "verification": {"verified": true, "reason": "valid"}}},
+			{"sha": "fedcba9876543210", "commit": {"message": "Fix typo", "author": {"name": "Mona"}, "verification": {"verified": false, "reason": "unknown_key"}}}]`
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"pr", "commits", "5", "-r", "TheAlgorithms/Go"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := "\x1b[32mSHA: 0123456\n\x1b[32mAuthor: Mona (octocat)\n\x1b[32mSubject: Add heap sort\n\x1b[32mVerified: yes\n" +
+		"\x1b[33mSHA: fedcba9\n\x1b[33mAuthor: Mona\n\x1b[33mSubject: Fix typo\n\x1b[33mVerified: no (unknown_key)\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
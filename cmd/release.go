@@ -0,0 +1,70 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+)
+
+// releaseCmd represents the release command
+var releaseCmd = &cobra.Command{
+	Use:   "release",
+	Short: "Manage the releases of a repository",
+	Long:  `List, view, create, edit and delete releases and upload and download their assets.`,
+}
+
+// releaseKind describes whether a release is a draft or prerelease.
+func releaseKind(release *github.RepositoryRelease) string {
+	switch {
+	case release.GetDraft():
+		return "draft"
+	case release.GetPrerelease():
+		return "prerelease"
+	}
+	return "release"
+}
+
+// printRelease writes the details of a release, coloured yellow when it is a
+// draft or prerelease.
+func printRelease(w io.Writer, release *github.RepositoryRelease) {
+	statusColour := green
+	if release.GetDraft() || release.GetPrerelease() {
+		statusColour = yellow
+	}
+	fmt.Fprintf(w, "%sTag: %s\n", statusColour, release.GetTagName())
+	fmt.Fprintf(w, "%sTitle: %s\n", statusColour, release.GetName())
+	fmt.Fprintf(w, "%sKind: %s\n", statusColour, releaseKind(release))
+	if release.PublishedAt != nil {
+		fmt.Fprintf(w, "%sPublished: %s\n", statusColour, release.GetPublishedAt().Format("2006-01-02 15:04"))
+	}
+	fmt.Fprintf(w, "%sURL: %s\n", statusColour, release.GetHTMLURL())
+	fmt.Fprintf(w, "%sNotes: %s\n", statusColour, release.GetBody())
+}
+
+func init() {
+	rootCmd.AddCommand(releaseCmd)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newReleaseCreateCmd() *cobra.Command {
+	var repo string
+	var release api.NewRelease
+	var releaseCreateCmd = &cobra.Command{
+		Use:   "create <tag>",
+		Short: "Create a release",
+		Long: `Create a release of tag. The tag is created from --target, by default the
+default branch, if it doesn't exist yet. With --generate-notes GitHub writes the
+notes from the PRs merged since the previous release, after any given --notes.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			release.TagName = args[0]
+			ghApi := api.NewApi(client)
+			created, err := ghApi.CreateRelease(repo, &release)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sCreated %s %s\n", green, releaseKind(created), created.GetTagName())
+			fmt.Fprintf(cmd.OutOrStdout(), "%sURL: %s\n", green, created.GetHTMLURL())
+			return nil
+		},
+	}
+	releaseCreateCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to create the release in")
+	releaseCreateCmd.Flags().StringVarP(&release.Name, "title", "t", "", "title of the release")
+	releaseCreateCmd.Flags().StringVarP(&release.Body, "notes", "n", "", "release notes")
+	releaseCreateCmd.Flags().StringVar(&release.TargetCommitish, "target", "", "branch or SHA to create the tag from")
+	releaseCreateCmd.Flags().BoolVarP(&release.Draft, "draft", "d", false, "save the release as a draft")
+	releaseCreateCmd.Flags().BoolVarP(&release.Prerelease, "prerelease", "p", false, "mark the release as a prerelease")
+	releaseCreateCmd.Flags().BoolVar(&release.GenerateReleaseNotes, "generate-notes", false, "generate the release notes from merged PRs")
+	_ = releaseCreateCmd.MarkFlagRequired("repo")
+	return releaseCreateCmd
+}
+
+func init() {
+	releaseCmd.AddCommand(newReleaseCreateCmd())
+}
@@ -0,0 +1,74 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newReleaseDeleteCmd() *cobra.Command {
+	var repo string
+	var yes bool
+	var releaseDeleteCmd = &cobra.Command{
+		Use:   "delete <tag>",
+		Short: "Delete a release",
+		Long:  `Delete a release and its assets. The tag itself is kept.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			release, err := ghApi.GetRelease(repo, args[0])
+			if err != nil {
+				return err
+			}
+			if !yes {
+				ok, err := confirm(cmd, fmt.Sprintf("Delete release %s with %d assets?", release.GetTagName(), len(release.Assets)))
+				if err != nil {
+					return err
+				}
+				if !ok {
+					fmt.Fprintln(cmd.OutOrStdout(), "Nothing deleted")
+					return nil
+				}
+			}
+			if err := ghApi.DeleteRelease(repo, release.GetID()); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sDeleted release %s\n", red, release.GetTagName())
+			return nil
+		},
+	}
+	releaseDeleteCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the release belongs to")
+	releaseDeleteCmd.Flags().BoolVarP(&yes, "yes", "y", false, "delete without asking for confirmation")
+	_ = releaseDeleteCmd.MarkFlagRequired("repo")
+	return releaseDeleteCmd
+}
+
+func init() {
+	releaseCmd.AddCommand(newReleaseDeleteCmd())
+}
@@ -0,0 +1,189 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// isChecksumAsset reports whether an asset holds the checksums of the other
+// assets, either all of them (checksums.txt, SHA256SUMS) or a single one
+// (<asset>.sha256).
+func isChecksumAsset(name string) bool {
+	lower := strings.ToLower(name)
+	return strings.HasSuffix(lower, ".sha256") ||
+		strings.Contains(lower, "checksums") ||
+		strings.Contains(lower, "sha256sums")
+}
+
+// parseChecksums reads sha256sum output, "<hex>  <name>" or "<hex> *<name>"
+// per line, into a map from file name to checksum. A line without a name is
+// the checksum of single.
+func parseChecksums(r io.Reader, single string) (map[string]string, error) {
+	sums := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		switch len(fields) {
+		case 0:
+			continue
+		case 1:
+			sums[single] = strings.ToLower(fields[0])
+		default:
+			name := strings.TrimPrefix(fields[1], "*")
+			sums[filepath.Base(name)] = strings.ToLower(fields[0])
+		}
+	}
+	return sums, scanner.Err()
+}
+
+// releaseChecksums downloads the checksum assets of release and merges them.
+func releaseChecksums(ghApi *api.API, release *github.RepositoryRelease) (map[string]string, error) {
+	sums := make(map[string]string)
+	for i := range release.Assets {
+		asset := &release.Assets[i]
+		if !isChecksumAsset(asset.GetName()) {
+			continue
+		}
+		body, err := ghApi.DownloadReleaseAsset(asset)
+		if err != nil {
+			return nil, err
+		}
+		assetSums, err := parseChecksums(body, strings.TrimSuffix(asset.GetName(), filepath.Ext(asset.GetName())))
+		body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", asset.GetName(), err)
+		}
+		for name, sum := range assetSums {
+			sums[name] = sum
+		}
+	}
+	return sums, nil
+}
+
+// downloadAsset writes asset to dir and returns its SHA-256 checksum.
+func downloadAsset(ghApi *api.API, asset *github.ReleaseAsset, dir string) (string, error) {
+	body, err := ghApi.DownloadReleaseAsset(asset)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+	f, err := os.Create(filepath.Join(dir, filepath.Base(asset.GetName())))
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(f, hash), body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("downloading %s: %w", asset.GetName(), err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func newReleaseDownloadCmd() *cobra.Command {
+	var repo string
+	var pattern, dir string
+	var releaseDownloadCmd = &cobra.Command{
+		Use:   "download <tag>",
+		Short: "Download the assets of a release",
+		Long: `Download the assets of a release matching --pattern, all of them by default.
+When the release has a checksums file (checksums.txt, SHA256SUMS or
+<asset>.sha256) each download is verified against it, and files that don't
+match are removed again.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			if _, err := filepath.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+			}
+			ghApi := api.NewApi(client)
+			release, err := ghApi.GetRelease(repo, args[0])
+			if err != nil {
+				return err
+			}
+			var assets []*github.ReleaseAsset
+			for i := range release.Assets {
+				asset := &release.Assets[i]
+				if ok, _ := filepath.Match(pattern, asset.GetName()); ok {
+					assets = append(assets, asset)
+				}
+			}
+			if len(assets) == 0 {
+				return fmt.Errorf("no assets of release %s match %q", release.GetTagName(), pattern)
+			}
+			sums, err := releaseChecksums(ghApi, release)
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			for _, asset := range assets {
+				sum, err := downloadAsset(ghApi, asset, dir)
+				if err != nil {
+					return err
+				}
+				expected, ok := sums[asset.GetName()]
+				switch {
+				case isChecksumAsset(asset.GetName()):
+					fmt.Fprintf(out, "%sDownloaded %s\n", green, asset.GetName())
+				case !ok:
+					fmt.Fprintf(cmd.ErrOrStderr(), "Warning: no checksum for %s, it wasn't verified\n", asset.GetName())
+					fmt.Fprintf(out, "%sDownloaded %s\n", yellow, asset.GetName())
+				case expected != sum:
+					_ = os.Remove(filepath.Join(dir, filepath.Base(asset.GetName())))
+					return fmt.Errorf("checksum of %s doesn't match: expected %s, got %s", asset.GetName(), expected, sum)
+				default:
+					fmt.Fprintf(out, "%sDownloaded %s (checksum verified)\n", green, asset.GetName())
+				}
+			}
+			return nil
+		},
+	}
+	releaseDownloadCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the release belongs to")
+	releaseDownloadCmd.Flags().StringVarP(&pattern, "pattern", "p", "*", "glob the asset names have to match")
+	releaseDownloadCmd.Flags().StringVarP(&dir, "dir", "D", ".", "directory to download the assets to")
+	_ = releaseDownloadCmd.MarkFlagRequired("repo")
+	return releaseDownloadCmd
+}
+
+func init() {
+	releaseCmd.AddCommand(newReleaseDownloadCmd())
+}
@@ -0,0 +1,92 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newReleaseEditCmd() *cobra.Command {
+	var repo string
+	var tag, title, notes, target string
+	var draft, prerelease bool
+	var releaseEditCmd = &cobra.Command{
+		Use:   "edit <tag>",
+		Short: "Edit a release",
+		Long:  `Edit a release. Publish a draft with --draft=false.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			var edit github.RepositoryRelease
+			flags := cmd.Flags()
+			if flags.Changed("tag") {
+				edit.TagName = &tag
+			}
+			if flags.Changed("title") {
+				edit.Name = &title
+			}
+			if flags.Changed("notes") {
+				edit.Body = &notes
+			}
+			if flags.Changed("target") {
+				edit.TargetCommitish = &target
+			}
+			if flags.Changed("draft") {
+				edit.Draft = &draft
+			}
+			if flags.Changed("prerelease") {
+				edit.Prerelease = &prerelease
+			}
+			ghApi := api.NewApi(client)
+			release, err := ghApi.GetRelease(repo, args[0])
+			if err != nil {
+				return err
+			}
+			edited, err := ghApi.EditRelease(repo, release.GetID(), &edit)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sEdited %s %s\n", green, releaseKind(edited), edited.GetTagName())
+			return nil
+		},
+	}
+	releaseEditCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the release belongs to")
+	releaseEditCmd.Flags().StringVar(&tag, "tag", "", "new tag of the release")
+	releaseEditCmd.Flags().StringVarP(&title, "title", "t", "", "new title")
+	releaseEditCmd.Flags().StringVarP(&notes, "notes", "n", "", "new release notes")
+	releaseEditCmd.Flags().StringVar(&target, "target", "", "branch or SHA to create the tag from")
+	releaseEditCmd.Flags().BoolVarP(&draft, "draft", "d", false, "whether the release is a draft")
+	releaseEditCmd.Flags().BoolVarP(&prerelease, "prerelease", "p", false, "whether the release is a prerelease")
+	_ = releaseEditCmd.MarkFlagRequired("repo")
+	return releaseEditCmd
+}
+
+func init() {
+	releaseCmd.AddCommand(newReleaseEditCmd())
+}
@@ -0,0 +1,71 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newReleaseListCmd() *cobra.Command {
+	var repo string
+	var asJSON bool
+	var releaseListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the releases of the stated repository",
+		Long:  `List the releases of the stated repository, newest first. Drafts are only listed for collaborators.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			releases, err := ghApi.ListReleases(repo)
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				return printJSON(cmd.OutOrStdout(), releases)
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			for _, release := range releases {
+				published := ""
+				if release.PublishedAt != nil {
+					published = release.GetPublishedAt().Format("2006-01-02")
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", release.GetTagName(), release.GetName(), releaseKind(release), published)
+			}
+			return w.Flush()
+		},
+	}
+	releaseListCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to list the releases of")
+	releaseListCmd.Flags().BoolVar(&asJSON, "json", false, "print the releases as JSON")
+	_ = releaseListCmd.MarkFlagRequired("repo")
+	return releaseListCmd
+}
+
+func init() {
+	releaseCmd.AddCommand(newReleaseListCmd())
+}
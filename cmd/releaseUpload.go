@@ -0,0 +1,161 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// progressReader reports how much of a file has been read to w, when w is a
+// terminal.
+type progressReader struct {
+	r     io.Reader
+	w     io.Writer
+	name  string
+	size  int64
+	read  int64
+	shown int64
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.read += int64(n)
+	if p.size > 0 && isTerminal(p.w) {
+		if percent := p.read * 100 / p.size; percent != p.shown {
+			p.shown = percent
+			fmt.Fprintf(p.w, "\rUploading %s: %d%%", p.name, percent)
+		}
+	}
+	return n, err
+}
+
+// assetContentType guesses the content type of an asset from its extension.
+func assetContentType(name string) string {
+	if contentType := mime.TypeByExtension(filepath.Ext(name)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
+
+func newReleaseUploadCmd() *cobra.Command {
+	var repo string
+	var clobber bool
+	var releaseUploadCmd = &cobra.Command{
+		Use:   "upload <tag> <files>...",
+		Short: "Upload files as assets of a release",
+		Long: `Upload files as assets of a release. The files are streamed to the uploads
+host rather than read into memory, so large archives are fine.
+
+With --clobber an asset with the same name is only replaced once its
+replacement has been uploaded, so a failed upload leaves it in place.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			release, err := ghApi.GetRelease(repo, args[0])
+			if err != nil {
+				return err
+			}
+			existing := make(map[string]int64)
+			for _, asset := range release.Assets {
+				existing[asset.GetName()] = asset.GetID()
+			}
+			for _, path := range args[1:] {
+				name := filepath.Base(path)
+				id, ok := existing[name]
+				if !ok {
+					if _, err := uploadAsset(cmd, ghApi, release, path, name); err != nil {
+						return err
+					}
+					continue
+				}
+				if !clobber {
+					return fmt.Errorf("release %s already has an asset called %s, use --clobber to replace it", release.GetTagName(), name)
+				}
+				if err := replaceAsset(cmd, ghApi, repo, release, path, name, id); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	releaseUploadCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the release belongs to")
+	releaseUploadCmd.Flags().BoolVar(&clobber, "clobber", false, "replace assets with the same name")
+	_ = releaseUploadCmd.MarkFlagRequired("repo")
+	return releaseUploadCmd
+}
+
+// replaceAsset replaces the asset with the given id by uploading path under a
+// temporary name first, so the old asset is only deleted once the upload has
+// succeeded.
+func replaceAsset(cmd *cobra.Command, ghApi *api.API, repo string, release *github.RepositoryRelease, path, name string, id int64) error {
+	temporary := "replacing-" + name
+	uploaded, err := uploadAsset(cmd, ghApi, release, path, temporary)
+	if err != nil {
+		return err
+	}
+	if err := ghApi.DeleteReleaseAsset(repo, id); err != nil {
+		return fmt.Errorf("%w, the new version was uploaded as %s", err, temporary)
+	}
+	if _, err := ghApi.RenameReleaseAsset(repo, uploaded.GetID(), name); err != nil {
+		return fmt.Errorf("%w, the new version was uploaded as %s", err, temporary)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%sReplaced %s\n", green, name)
+	return nil
+}
+
+func uploadAsset(cmd *cobra.Command, ghApi *api.API, release *github.RepositoryRelease, path, name string) (*github.ReleaseAsset, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	progress := &progressReader{r: f, w: cmd.ErrOrStderr(), name: name, size: info.Size()}
+	asset, err := ghApi.UploadReleaseAsset(release, name, assetContentType(name), progress, info.Size())
+	if isTerminal(progress.w) {
+		fmt.Fprintln(progress.w)
+	}
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%sUploaded %s (%d bytes)\n", green, asset.GetName(), asset.GetSize())
+	return asset, nil
+}
+
+func init() {
+	releaseCmd.AddCommand(newReleaseUploadCmd())
+}
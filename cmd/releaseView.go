@@ -0,0 +1,67 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newReleaseViewCmd() *cobra.Command {
+	var repo string
+	var asJSON bool
+	var releaseViewCmd = &cobra.Command{
+		Use:   "view <tag>",
+		Short: "View a release and its assets",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			release, err := ghApi.GetRelease(repo, args[0])
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			if asJSON {
+				return printJSON(out, release)
+			}
+			printRelease(out, release)
+			for _, asset := range release.Assets {
+				fmt.Fprintf(out, "%sAsset: %s (%d bytes, %d downloads)\n", reset, asset.GetName(), asset.GetSize(), asset.GetDownloadCount())
+			}
+			return nil
+		},
+	}
+	releaseViewCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the release belongs to")
+	releaseViewCmd.Flags().BoolVar(&asJSON, "json", false, "print the release as JSON")
+	_ = releaseViewCmd.MarkFlagRequired("repo")
+	return releaseViewCmd
+}
+
+func init() {
+	releaseCmd.AddCommand(newReleaseViewCmd())
+}
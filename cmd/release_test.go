@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseChecksums(t *testing.T) {
+	got, err := parseChecksums(strings.NewReader("ABC123  go.tar.gz\ndef456 *dist/go.zip\n\n"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"go.tar.gz": "abc123", "go.zip": "def456"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseChecksums() = %v, want %v", got, want)
+	}
+	got, _ = parseChecksums(strings.NewReader("abc123\n"), "go.tar.gz")
+	if got["go.tar.gz"] != "abc123" {
+		t.Errorf("parseChecksums() of a single checksum = %v", got)
+	}
+}
+
+func TestReleaseListCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/repos/TheAlgorithms/Go/releases" {
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body: ioutil.NopCloser(bytes.NewBufferString(`[{"tag_name": "v2.0.0", "name": "Two", "draft": true},
+				{"tag_name": "v1.0.0", "name": "One", "published_at": "2022-03-01T10:00:00Z"}]`)),
+			Header: make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"release", "list", "-r", "TheAlgorithms/Go"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := "v2.0.0  Two  draft    \nv1.0.0  One  release  2022-03-01\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestReleaseDownloadCmd(t *testing.T) {
+	assets := map[string]string{
+		"/assets/1": "archive",
+		"/assets/2": "",
+		"/assets/3": "notes",
+	}
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		body := `{"tag_name": "v1.0.0", "assets": [
+			{"name": "go.tar.gz", "url": "https://api.github.com/assets/1"},
+			{"name": "checksums.txt", "url": "https://api.github.com/assets/2"},
+			{"name": "notes.txt", "url": "https://api.github.com/assets/3"}]}`
+		if content, ok := assets[req.URL.Path]; ok {
+			body = content
+		} else if req.URL.Path != "/repos/TheAlgorithms/Go/releases/tags/v1.0.0" {
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	dir := t.TempDir()
+
+	assets["/assets/2"] = "2ea7e7bf3a4b1d5a9c4e40af71c8da6ff1d27a0d2f2ac2ae3a3a6d3a9c1d6b4b  go.tar.gz\n"
+	buff := new(bytes.Buffer)
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"release", "download", "v1.0.0", "-r", "TheAlgorithms/Go", "-p", "*.tar.gz", "-D", dir})
+	err := rootCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "checksum of go.tar.gz doesn't match") {
+		t.Fatalf("download with a wrong checksum = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "go.tar.gz")); !os.IsNotExist(err) {
+		t.Errorf("file with a wrong checksum wasn't removed: %v", err)
+	}
+
+	// sha256 of "archive"
+	assets["/assets/2"] = "0eb3e36bfb24dcd9bb1d1bece1531216b59539a8fde17ee80224af0653c92aa3  go.tar.gz\n"
+	buff.Reset()
+	rootCmd.SetArgs([]string{"release", "download", "v1.0.0", "-r", "TheAlgorithms/Go", "-p", "*.tar.gz", "-D", dir})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got, want := buff.String(), green+"Downloaded go.tar.gz (checksum verified)\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if content, _ := os.ReadFile(filepath.Join(dir, "go.tar.gz")); string(content) != "archive" {
+		t.Errorf("downloaded %q, want archive", content)
+	}
+}
+
+func TestReleaseUploadCmdClobber(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "go.tar.gz")
+	if err := os.WriteFile(file, []byte("archive"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	uploadStatus := 422
+	var requests []string
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path+" "+req.URL.Query().Get("name"))
+		status := 200
+		body := `{}`
+		switch req.Method + " " + req.URL.Path {
+		case "GET /repos/TheAlgorithms/Go/releases/tags/v1.0.0":
+			body = `{"tag_name": "v1.0.0", "upload_url": "https://uploads.github.com/repos/TheAlgorithms/Go/releases/1/assets{?name,label}",
+				"assets": [{"id": 7, "name": "go.tar.gz"}]}`
+		case "POST /repos/TheAlgorithms/Go/releases/1/assets":
+			status, body = uploadStatus, `{"id": 8, "name": "replacing-go.tar.gz", "size": 7}`
+		case "DELETE /repos/TheAlgorithms/Go/releases/assets/7":
+			status = 204
+		case "PATCH /repos/TheAlgorithms/Go/releases/assets/8":
+			body = `{"id": 8, "name": "go.tar.gz"}`
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+			Request:    req,
+		}
+	})
+	buff := new(bytes.Buffer)
+	rootCmd.SetOut(buff)
+	rootCmd.SetErr(new(bytes.Buffer))
+	defer rootCmd.SetErr(nil)
+	rootCmd.SetArgs([]string{"release", "upload", "v1.0.0", file, "-r", "TheAlgorithms/Go", "--clobber"})
+	if err := rootCmd.Execute(); err == nil {
+		t.Fatalf("failed upload didn't fail")
+	}
+	// The old asset is kept when its replacement can't be uploaded.
+	if len(requests) != 2 {
+		t.Errorf("failed upload made requests %v", requests)
+	}
+
+	requests = nil
+	uploadStatus = 201
+	buff.Reset()
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := []string{
+		"GET /repos/TheAlgorithms/Go/releases/tags/v1.0.0 ",
+		"POST /repos/TheAlgorithms/Go/releases/1/assets replacing-go.tar.gz",
+		"DELETE /repos/TheAlgorithms/Go/releases/assets/7 ",
+		"PATCH /repos/TheAlgorithms/Go/releases/assets/8 ",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got requests %q, want %q", requests, want)
+	}
+	if got, want := buff.String(), green+"Uploaded replacing-go.tar.gz (7 bytes)\n"+green+"Replaced go.tar.gz\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
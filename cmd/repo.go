@@ -0,0 +1,97 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// repoCmd represents the repo command
+var repoCmd = &cobra.Command{
+	Use:   "repo",
+	Short: "Work with repositories",
+	Long:  `View, list, create, fork and clone repositories.`,
+}
+
+// remoteURLPattern matches the HTTPS and SSH URLs of GitHub repositories.
+var remoteURLPattern = regexp.MustCompile(`^(?:https://|ssh://git@|git@)github\.com[:/]([^/]+)/([^/]+?)(?:\.git)?/?$`)
+
+// parseRepoURL returns the owner/repo a GitHub remote URL points to.
+func parseRepoURL(url string) (string, error) {
+	m := remoteURLPattern.FindStringSubmatch(url)
+	if m == nil {
+		return "", fmt.Errorf("%s isn't the URL of a GitHub repository", url)
+	}
+	return m[1] + "/" + m[2], nil
+}
+
+// currentRepo returns the owner/repo the origin remote of the current
+// checkout points to.
+func currentRepo() (string, error) {
+	url, err := gitClient.RemoteURL("origin")
+	if err != nil {
+		return "", err
+	}
+	if url == "" {
+		return "", fmt.Errorf("no repository given and the current directory has no origin remote")
+	}
+	return parseRepoURL(url)
+}
+
+// repoArg returns the repository given as the only argument, or the one of
+// the current checkout when there is no argument.
+func repoArg(args []string) (string, error) {
+	if len(args) == 0 {
+		return currentRepo()
+	}
+	if strings.Count(args[0], "/") != 1 {
+		return "", fmt.Errorf("invalid repository %q, expected owner/repo", args[0])
+	}
+	return args[0], nil
+}
+
+// cloneRepo clones url into dir. For forks, upstream is the URL of the parent
+// repository, which is added as the upstream remote.
+func cloneRepo(cmd *cobra.Command, url, dir, upstream string) error {
+	if err := gitClient.Clone(url, dir); err != nil {
+		return err
+	}
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "%sCloned %s into %s\n", green, url, dir)
+	if upstream == "" {
+		return nil
+	}
+	if err := gitClient.In(dir).AddRemote("upstream", upstream); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%sAdded remote upstream for %s\n", green, upstream)
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(repoCmd)
+}
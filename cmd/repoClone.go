@@ -0,0 +1,68 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newRepoCloneCmd() *cobra.Command {
+	var repoCloneCmd = &cobra.Command{
+		Use:   "clone <owner/repo> [<directory>]",
+		Short: "Clone a repository",
+		Long: `Clone a repository into directory, by default one named after the repository.
+When the repository is a fork, the forked repository is added as the upstream remote.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			repo, err := repoArg(args[:1])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			r, err := ghApi.GetRepo(repo)
+			if err != nil {
+				return err
+			}
+			dir := r.GetName()
+			if len(args) == 2 {
+				dir = args[1]
+			}
+			upstream := ""
+			if r.GetFork() {
+				if r.Parent == nil {
+					return fmt.Errorf("GitHub didn't say which repository %s is a fork of", repo)
+				}
+				upstream = r.GetParent().GetCloneURL()
+			}
+			return cloneRepo(cmd, r.GetCloneURL(), dir, upstream)
+		},
+	}
+	return repoCloneCmd
+}
+
+func init() {
+	repoCmd.AddCommand(newRepoCloneCmd())
+}
@@ -0,0 +1,102 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newRepoCreateCmd() *cobra.Command {
+	var org, description string
+	var private bool
+	var template string
+	var gitignore, license string
+	var clone bool
+	var repoCreateCmd = &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a repository",
+		Long: `Create a repository for yourself or, with --org, for an organisation. It is
+either created from a template repository or initialised with a README and
+optionally a .gitignore and license, eg --gitignore Go --license mit.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if template != "" && (gitignore != "" || license != "") {
+				return fmt.Errorf("--gitignore and --license can't be used with --template")
+			}
+			ghApi := api.NewApi(client)
+			var created *github.Repository
+			var err error
+			if template != "" {
+				created, err = ghApi.CreateRepoFromTemplate(template, &api.TemplateRepo{
+					Owner:       org,
+					Name:        name,
+					Description: description,
+					Private:     private,
+				})
+			} else {
+				repo := &github.Repository{
+					Name:     &name,
+					Private:  &private,
+					AutoInit: github.Bool(true),
+				}
+				if description != "" {
+					repo.Description = &description
+				}
+				if gitignore != "" {
+					repo.GitignoreTemplate = &gitignore
+				}
+				if license != "" {
+					repo.LicenseTemplate = &license
+				}
+				created, err = ghApi.CreateRepo(org, repo)
+			}
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "%sCreated %s\n", green, created.GetFullName())
+			fmt.Fprintf(out, "%sURL: %s\n", green, created.GetHTMLURL())
+			if clone {
+				return cloneRepo(cmd, created.GetCloneURL(), created.GetName(), "")
+			}
+			return nil
+		},
+	}
+	repoCreateCmd.Flags().StringVar(&org, "org", "", "organisation to create the repository in")
+	repoCreateCmd.Flags().StringVarP(&description, "description", "d", "", "description of the repository")
+	repoCreateCmd.Flags().BoolVar(&private, "private", false, "make the repository private")
+	repoCreateCmd.Flags().StringVarP(&template, "template", "t", "", "template repository to copy, as owner/repo")
+	repoCreateCmd.Flags().StringVar(&gitignore, "gitignore", "", "name of the .gitignore template, eg Go")
+	repoCreateCmd.Flags().StringVar(&license, "license", "", "keyword of the license, eg mit or apache-2.0")
+	repoCreateCmd.Flags().BoolVarP(&clone, "clone", "c", false, "clone the repository into the current directory")
+	return repoCreateCmd
+}
+
+func init() {
+	repoCmd.AddCommand(newRepoCreateCmd())
+}
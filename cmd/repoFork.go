@@ -0,0 +1,118 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// GitHub creates forks in the background, so the fork is checked forkAttempts
+// times, forkRetryDelay apart, before giving up on cloning or fetching it.
+const (
+	forkAttempts   = 10
+	forkRetryDelay = 3 * time.Second
+)
+
+// waitForFork waits until git can read the fork at url.
+func waitForFork(url string) error {
+	var err error
+	for i := 0; i < forkAttempts; i++ {
+		if i > 0 {
+			sleep(forkRetryDelay)
+		}
+		if err = gitClient.LsRemote(url); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("the fork still can't be read, try again in a moment: %w", err)
+}
+
+func newRepoForkCmd() *cobra.Command {
+	var org string
+	var clone, remote bool
+	var repoForkCmd = &cobra.Command{
+		Use:   "fork [<owner/repo>]",
+		Short: "Fork a repository",
+		Long: `Fork a repository, by default the one of the current directory.
+
+With --clone the fork is cloned and the forked repository added as its upstream
+remote. With --remote the origin remote of the current directory is renamed to
+upstream and the fork added as origin instead. As GitHub creates forks in the
+background, both wait until the fork can be read first.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if clone && remote {
+				return fmt.Errorf("only one of --clone or --remote can be given")
+			}
+			repo, err := repoArg(args)
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			parent, err := ghApi.GetRepo(repo)
+			if err != nil {
+				return err
+			}
+			fork, err := ghApi.ForkRepo(repo, org)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "%sForked %s to %s\n", green, repo, fork.GetFullName())
+			if !clone && !remote {
+				return nil
+			}
+			cmd.SilenceUsage = true
+			url := fork.GetCloneURL()
+			if err := waitForFork(url); err != nil {
+				return err
+			}
+			if clone {
+				return cloneRepo(cmd, url, fork.GetName(), parent.GetCloneURL())
+			}
+			if err := gitClient.RenameRemote("origin", "upstream"); err != nil {
+				return err
+			}
+			if err := gitClient.AddRemote("origin", url); err != nil {
+				if undoErr := gitClient.RenameRemote("upstream", "origin"); undoErr != nil {
+					return fmt.Errorf("%v, and renaming upstream back to origin failed: %v", err, undoErr)
+				}
+				return err
+			}
+			fmt.Fprintf(out, "%sRenamed remote origin to upstream and added %s as origin\n", green, url)
+			return nil
+		},
+	}
+	repoForkCmd.Flags().StringVar(&org, "org", "", "organisation to create the fork in")
+	repoForkCmd.Flags().BoolVarP(&clone, "clone", "c", false, "clone the fork")
+	repoForkCmd.Flags().BoolVar(&remote, "remote", false, "add the fork as the origin remote of the current directory")
+	return repoForkCmd
+}
+
+func init() {
+	repoCmd.AddCommand(newRepoForkCmd())
+}
@@ -0,0 +1,118 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/go-github/github"
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// repoFilter selects repositories by visibility, language and archived state.
+type repoFilter struct {
+	visibility string
+	language   string
+	archived   bool
+	noArchived bool
+}
+
+func (f repoFilter) matches(repo *github.Repository) bool {
+	switch {
+	case f.visibility == "public" && repo.GetPrivate(),
+		f.visibility == "private" && !repo.GetPrivate(),
+		f.language != "" && !strings.EqualFold(f.language, repo.GetLanguage()),
+		f.archived && !repo.GetArchived(),
+		f.noArchived && repo.GetArchived():
+		return false
+	}
+	return true
+}
+
+func newRepoListCmd() *cobra.Command {
+	var filter repoFilter
+	var asJSON bool
+	var repoListCmd = &cobra.Command{
+		Use:   "list [<owner>]",
+		Short: "List the repositories of a user or organisation",
+		Long: `List the repositories of a user or organisation, or your own without an
+argument. Private repositories are only listed when you have access to them.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch filter.visibility {
+			case "all", "public", "private":
+			default:
+				return fmt.Errorf("visibility must be one of all, public or private")
+			}
+			if filter.archived && filter.noArchived {
+				return fmt.Errorf("only one of --archived or --no-archived can be given")
+			}
+			owner := ""
+			if len(args) == 1 {
+				owner = args[0]
+			}
+			ghApi := api.NewApi(client)
+			repos, err := ghApi.ListRepos(owner)
+			if err != nil {
+				return err
+			}
+			var matching []*github.Repository
+			for _, repo := range repos {
+				if filter.matches(repo) {
+					matching = append(matching, repo)
+				}
+			}
+			if asJSON {
+				return printJSON(cmd.OutOrStdout(), matching)
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			for _, repo := range matching {
+				visibility := "public"
+				if repo.GetPrivate() {
+					visibility = "private"
+				}
+				if repo.GetFork() {
+					visibility += ", fork"
+				}
+				if repo.GetArchived() {
+					visibility += ", archived"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d stars\t%s\n", repo.GetFullName(), visibility, repo.GetLanguage(), repo.GetStargazersCount(), repo.GetDescription())
+			}
+			return w.Flush()
+		},
+	}
+	repoListCmd.Flags().StringVar(&filter.visibility, "visibility", "all", "only list all, public or private repositories")
+	repoListCmd.Flags().StringVarP(&filter.language, "language", "l", "", "only list repositories in this language")
+	repoListCmd.Flags().BoolVar(&filter.archived, "archived", false, "only list archived repositories")
+	repoListCmd.Flags().BoolVar(&filter.noArchived, "no-archived", false, "leave out archived repositories")
+	repoListCmd.Flags().BoolVar(&asJSON, "json", false, "print the repositories as JSON")
+	return repoListCmd
+}
+
+func init() {
+	repoCmd.AddCommand(newRepoListCmd())
+}
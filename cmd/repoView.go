@@ -0,0 +1,106 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// renderMarkdown prepares Markdown for the terminal. Headings are shown
+// without their #s in yellow and code blocks without their fences in gray,
+// everything else is left alone.
+func renderMarkdown(text string) string {
+	var b strings.Builder
+	inCode := false
+	for _, line := range strings.SplitAfter(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "```"), strings.HasPrefix(trimmed, "~~~"):
+			inCode = !inCode
+		case inCode:
+			b.WriteString(colourLine(gray, line))
+		case strings.HasPrefix(trimmed, "#"):
+			heading := strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+			b.WriteString(colourLine(yellow, heading+"\n"))
+		default:
+			b.WriteString(line)
+		}
+	}
+	return b.String()
+}
+
+func newRepoViewCmd() *cobra.Command {
+	var repoViewCmd = &cobra.Command{
+		Use:   "view [<owner/repo>]",
+		Short: "View a repository and its README",
+		Long: `View the description, topics, default branch and stars of a repository and
+its README. Without an argument the repository of the current directory is shown.
+Output to a terminal is shown through $PAGER, or less when PAGER isn't set.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			repo, err := repoArg(args)
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			r, err := ghApi.GetRepo(repo)
+			if err != nil {
+				return err
+			}
+			readme, err := ghApi.GetReadme(repo)
+			if err != nil {
+				return err
+			}
+
+			out, wait, err := startPager(cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "%sName: %s\n", green, r.GetFullName())
+			fmt.Fprintf(out, "%sDescription: %s\n", green, r.GetDescription())
+			fmt.Fprintf(out, "%sTopics: %s\n", green, strings.Join(r.Topics, ", "))
+			fmt.Fprintf(out, "%sDefault branch: %s\n", green, r.GetDefaultBranch())
+			fmt.Fprintf(out, "%sStars: %d\n", green, r.GetStargazersCount())
+			fmt.Fprintf(out, "%sURL: %s\n", green, r.GetHTMLURL())
+			if r.GetFork() {
+				fmt.Fprintf(out, "%sFork of: %s\n", green, r.GetParent().GetFullName())
+			}
+			fmt.Fprint(out, reset)
+			if readme == "" {
+				fmt.Fprintln(out, "\nThis repository has no README.")
+			} else {
+				fmt.Fprint(out, "\n"+renderMarkdown(readme))
+			}
+			return wait()
+		},
+	}
+	return repoViewCmd
+}
+
+func init() {
+	repoCmd.AddCommand(newRepoViewCmd())
+}
@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tjgurwara99/ghcli/git"
+)
+
+func TestParseRepoURL(t *testing.T) {
+	for _, url := range []string{
+		"https://github.com/TheAlgorithms/Go.git",
+		"https://github.com/TheAlgorithms/Go",
+		"git@github.com:TheAlgorithms/Go.git",
+		"ssh://git@github.com/TheAlgorithms/Go.git",
+	} {
+		if got, err := parseRepoURL(url); err != nil || got != "TheAlgorithms/Go" {
+			t.Errorf("parseRepoURL(%q) = %q, %v", url, got, err)
+		}
+	}
+	if _, err := parseRepoURL("https://gitlab.com/TheAlgorithms/Go.git"); err == nil {
+		t.Errorf("parseRepoURL() of a GitLab URL didn't fail")
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	got := renderMarkdown("# Go\n\nAlgorithms in Go.\n\n```go\nsort.Heap(xs)\n```\n")
+	want := "\x1b[33mGo\x1b[0m\n\nAlgorithms in Go.\n\n\x1b[37msort.Heap(xs)\x1b[0m\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestRepoListCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		body := `[]`
+		switch req.URL.Path {
+		case "/users/TheAlgorithms":
+			body = `{"type": "Organization"}`
+		case "/orgs/TheAlgorithms/repos":
+			body = `[{"full_name": "TheAlgorithms/Go", "language": "Go", "stargazers_count": 12, "description": "Algorithms in Go"},
+				{"full_name": "TheAlgorithms/Old", "language": "Go", "archived": true},
+				{"full_name": "TheAlgorithms/Python", "language": "Python"},
+				{"full_name": "TheAlgorithms/Secret", "language": "Go", "private": true, "fork": true}]`
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"repo", "list", "TheAlgorithms", "--language", "go", "--no-archived"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := "TheAlgorithms/Go      public         Go  12 stars  Algorithms in Go\n" +
+		"TheAlgorithms/Secret  private, fork  Go  0 stars   \n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestRepoCloneCmd(t *testing.T) {
+	dir := filepath.Dir(newTestCheckout(t))
+	gitClient = git.New(dir)
+	origin := filepath.Join(dir, "origin.git")
+
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != "/repos/octocat/Go" {
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		body := `{"name": "Go", "fork": true, "clone_url": "` + origin + `", "parent": {"clone_url": "` + origin + `"}}`
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"repo", "clone", "octocat/Go", "algorithms"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := runGit(t, filepath.Join(dir, "algorithms"), "remote"); got != "origin\nupstream" {
+		t.Errorf("got remotes %q, want origin and upstream", got)
+	}
+	got := buff.String()
+	want := "\x1b[32mCloned " + origin + " into algorithms\n\x1b[32mAdded remote upstream for " + origin + "\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestRepoForkCmd(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		ready   bool
+		dir     string
+		remotes string
+		want    string
+		wantErr bool
+	}{
+		{"remote", []string{"--remote", "--clone=false"}, true, ".", "origin fork.git\nupstream origin.git",
+			"\x1b[32mForked TheAlgorithms/Go to octocat/Go\n\x1b[32mRenamed remote origin to upstream and added FORK as origin\n", false},
+		{"clone", []string{"--clone", "--remote=false"}, true, "Go", "origin fork.git\nupstream origin.git",
+			"\x1b[32mForked TheAlgorithms/Go to octocat/Go\n\x1b[32mCloned FORK into Go\n\x1b[32mAdded remote upstream for ORIGIN\n", false},
+		{"remote never ready", []string{"--remote", "--clone=false"}, false, ".", "origin origin.git",
+			"\x1b[32mForked TheAlgorithms/Go to octocat/Go\n", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkout := newTestCheckout(t)
+			dir := filepath.Dir(checkout)
+			origin := filepath.Join(dir, "origin.git")
+			fork := filepath.Join(dir, "fork.git")
+
+			buff := new(bytes.Buffer)
+			oldClient := client
+			defer func() { client = oldClient }()
+			client = newTestClient(func(req *http.Request) *http.Response {
+				status := 200
+				body := `{}`
+				switch req.Method + " " + req.URL.Path {
+				case "GET /repos/TheAlgorithms/Go":
+					body = `{"name": "Go", "full_name": "TheAlgorithms/Go", "clone_url": "` + origin + `"}`
+				case "POST /repos/TheAlgorithms/Go/forks":
+					status = 202
+					body = `{"name": "Go", "full_name": "octocat/Go", "clone_url": "` + fork + `"}`
+				default:
+					t.Errorf("unexpected request %v %v", req.Method, req.URL)
+				}
+				return &http.Response{
+					StatusCode: status,
+					Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+					Header:     make(http.Header),
+				}
+			})
+			oldSleep := sleep
+			defer func() { sleep = oldSleep }()
+			var slept []time.Duration
+			sleep = func(d time.Duration) {
+				// The fork only appears once the command has waited for it.
+				if tt.ready && len(slept) == 0 {
+					runGit(t, dir, "clone", "--bare", origin, fork)
+				}
+				slept = append(slept, d)
+			}
+			rootCmd.SetOut(buff)
+			rootCmd.SetArgs(append([]string{"repo", "fork", "TheAlgorithms/Go"}, tt.args...))
+			err := rootCmd.Execute()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			wantSlept := 1
+			if !tt.ready {
+				wantSlept = forkAttempts - 1
+			}
+			if len(slept) != wantSlept || slept[len(slept)-1] != forkRetryDelay {
+				t.Errorf("slept %v, want %d waits of %v", slept, wantSlept, forkRetryDelay)
+			}
+			remotes := runGit(t, filepath.Join(checkout, tt.dir), "remote", "-v")
+			var got []string
+			for _, line := range strings.Split(remotes, "\n") {
+				if fields := strings.Fields(line); len(fields) == 3 && fields[2] == "(fetch)" {
+					got = append(got, fields[0]+" "+filepath.Base(fields[1]))
+				}
+			}
+			if strings.Join(got, "\n") != tt.remotes {
+				t.Errorf("got remotes %q, want %q", got, tt.remotes)
+			}
+			want := strings.NewReplacer("FORK", fork, "ORIGIN", origin).Replace(tt.want)
+			if buff.String() != want {
+				t.Errorf("got %q, want %q", buff.String(), want)
+			}
+		})
+	}
+}
@@ -27,9 +27,17 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+	"github.com/tjgurwara99/ghcli/git"
 )
 
-var client *http.Client = http.DefaultClient
+// client is shared by every command. Requests are authenticated with the
+// GITHUB_TOKEN environment variable when it is set.
+var client *http.Client = api.NewClient(os.Getenv("GITHUB_TOKEN"))
+
+// gitClient runs git in the current directory for commands which work with a
+// local checkout.
+var gitClient = git.New("")
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
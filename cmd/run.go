@@ -0,0 +1,100 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// runCmd represents the run command
+var runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Work with GitHub Actions workflow runs",
+	Long:  `List, view, watch, rerun and cancel workflow runs and read their logs.`,
+}
+
+// sleep is swapped out by tests which poll, so that they don't wait.
+var sleep = time.Sleep
+
+var stateColours = map[string]string{"pass": green, "fail": red, "pending": yellow, "skipping": gray}
+
+func parseRunID(arg string) (int64, error) {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid run ID %q", arg)
+	}
+	return id, nil
+}
+
+// elapsed returns how long something which started at start and completed at
+// end took, or an empty string if it hasn't started or completed.
+func elapsed(start, end *time.Time) string {
+	if start == nil || end == nil || end.Before(*start) {
+		return ""
+	}
+	return end.Sub(*start).Round(time.Second).String()
+}
+
+// printRun writes the details of a workflow run.
+func printRun(w io.Writer, run *api.WorkflowRun) {
+	state := conclusionState(run.Status, run.Conclusion)
+	colour := stateColours[state]
+	fmt.Fprintf(w, "%sRun: %s #%d (attempt %d)\n", colour, run.Name, run.RunNumber, run.RunAttempt)
+	fmt.Fprintf(w, "%sTitle: %s\n", colour, run.DisplayTitle)
+	fmt.Fprintf(w, "%sState: %s\n", colour, state)
+	fmt.Fprintf(w, "%sBranch: %s\n", colour, run.HeadBranch)
+	fmt.Fprintf(w, "%sEvent: %s\n", colour, run.Event)
+	if len(run.HeadSHA) >= 7 {
+		fmt.Fprintf(w, "%sCommit: %s\n", colour, run.HeadSHA[:7])
+	}
+	fmt.Fprintf(w, "%sURL: %s%s\n", colour, run.HTMLURL, reset)
+}
+
+// writeJobs writes a line per job with its state and duration followed, if
+// steps is true, by an indented line per step.
+func writeJobs(w io.Writer, jobs []*api.WorkflowJob, steps bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, job := range jobs {
+		state := conclusionState(job.Status, job.Conclusion)
+		fmt.Fprintf(tw, "%s%s\t%s\t%s%s\n", stateColours[state], state, job.Name, elapsed(job.StartedAt, job.CompletedAt), reset)
+		if !steps {
+			continue
+		}
+		for _, step := range job.Steps {
+			state := conclusionState(step.Status, step.Conclusion)
+			fmt.Fprintf(tw, "%s  %s\t%d %s\t%s%s\n", stateColours[state], state, step.Number, step.Name, elapsed(step.StartedAt, step.CompletedAt), reset)
+		}
+	}
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(runCmd)
+}
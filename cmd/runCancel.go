@@ -0,0 +1,61 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newRunCancelCmd() *cobra.Command {
+	var repo string
+	var runCancelCmd = &cobra.Command{
+		Use:   "cancel <run-id>",
+		Short: "Cancel a queued or in progress workflow run",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			id, err := parseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			if err := ghApi.CancelRun(repo, id); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sRequested the cancellation of run %d\n", red, id)
+			return nil
+		},
+	}
+	runCancelCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the run belongs to")
+	_ = runCancelCmd.MarkFlagRequired("repo")
+	return runCancelCmd
+}
+
+func init() {
+	runCmd.AddCommand(newRunCancelCmd())
+}
@@ -0,0 +1,202 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// zipEntryPath returns where the archive entry called name is extracted to
+// in dir. Entries which would end up outside of dir, such as ../../.bashrc,
+// are refused.
+func zipEntryPath(dir, name string) (string, error) {
+	if filepath.IsAbs(name) || strings.HasPrefix(name, "/") || strings.Contains(name, `\`) {
+		return "", fmt.Errorf("archive entry %q has an unsafe path", name)
+	}
+	target := filepath.Join(dir, filepath.FromSlash(name))
+	rel, err := filepath.Rel(dir, target)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("archive entry %q has an unsafe path", name)
+	}
+	return target, nil
+}
+
+// extractZip extracts archive into dir. Every entry is checked before
+// anything is written, so an archive with an unsafe entry isn't extracted at
+// all. Symbolic links are refused too, as they could point outside of dir.
+func extractZip(archive *zip.Reader, dir string) error {
+	targets := make([]string, len(archive.File))
+	for i, f := range archive.File {
+		if f.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("archive entry %q is a symbolic link", f.Name)
+		}
+		target, err := zipEntryPath(dir, f.Name)
+		if err != nil {
+			return err
+		}
+		targets[i] = target
+	}
+	for i, f := range archive.File {
+		if f.FileInfo().IsDir() {
+			if err := os.MkdirAll(targets[i], 0755); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := extractZipFile(f, targets[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractZipFile(f *zip.File, target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	r, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", f.Name, err)
+	}
+	defer r.Close()
+	perm := os.FileMode(0644)
+	if f.Mode()&0111 != 0 {
+		perm = 0755
+	}
+	w, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("extracting %s: %w", f.Name, err)
+	}
+	return nil
+}
+
+// downloadArtifact downloads the archive of artifact to a temporary file, as
+// reading a zip archive needs random access, and extracts it into dir.
+func downloadArtifact(ghApi *api.API, artifact *api.Artifact, dir string) error {
+	body, err := ghApi.DownloadArtifact(artifact)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	tmp, err := os.CreateTemp("", "ghcli-artifact-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	size, err := io.Copy(tmp, body)
+	if err != nil {
+		return fmt.Errorf("downloading %s: %w", artifact.Name, err)
+	}
+	archive, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", artifact.Name, err)
+	}
+	return extractZip(archive, dir)
+}
+
+func newRunDownloadCmd() *cobra.Command {
+	var repo, dir string
+	var names []string
+	var runDownloadCmd = &cobra.Command{
+		Use:   "download <run-id>",
+		Short: "Download the artifacts of a workflow run",
+		Long: `Download the artifacts of a workflow run, or only those given with --name, and
+extract them into --dir. When several artifacts are downloaded each is extracted
+into a directory named after it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			id, err := parseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			artifacts, err := ghApi.ListRunArtifacts(repo, id)
+			if err != nil {
+				return err
+			}
+			var selected []*api.Artifact
+			for _, artifact := range artifacts {
+				if len(names) == 0 && !artifact.Expired {
+					selected = append(selected, artifact)
+				}
+			}
+			for _, name := range names {
+				found := false
+				for _, artifact := range artifacts {
+					if artifact.Name == name {
+						selected = append(selected, artifact)
+						found = true
+					}
+				}
+				if !found {
+					return fmt.Errorf("run %d has no artifact called %q", id, name)
+				}
+			}
+			if len(selected) == 0 {
+				return fmt.Errorf("run %d has no artifacts to download", id)
+			}
+			for _, artifact := range selected {
+				target := dir
+				if len(selected) > 1 {
+					target, err = zipEntryPath(dir, artifact.Name)
+					if err != nil {
+						return err
+					}
+				}
+				if err := downloadArtifact(ghApi, artifact, target); err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%sDownloaded %s to %s\n", green, artifact.Name, target)
+			}
+			return nil
+		},
+	}
+	runDownloadCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the run belongs to")
+	runDownloadCmd.Flags().StringArrayVarP(&names, "name", "n", nil, "name of an artifact to download")
+	runDownloadCmd.Flags().StringVarP(&dir, "dir", "D", ".", "directory to extract the artifacts into")
+	_ = runDownloadCmd.MarkFlagRequired("repo")
+	return runDownloadCmd
+}
+
+func init() {
+	runCmd.AddCommand(newRunDownloadCmd())
+}
@@ -0,0 +1,73 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newRunListCmd() *cobra.Command {
+	var repo string
+	var opt api.RunListOptions
+	var asJSON bool
+	var runListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the recent workflow runs of the stated repository",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			runs, err := ghApi.ListRuns(repo, &opt)
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				return printJSON(cmd.OutOrStdout(), runs)
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			for _, run := range runs {
+				state := conclusionState(run.Status, run.Conclusion)
+				fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\t%s\t%d\t%s%s\n", stateColours[state], state, run.DisplayTitle, run.Name,
+					run.HeadBranch, run.Event, run.ID, run.CreatedAt.Format("2006-01-02 15:04"), reset)
+			}
+			return w.Flush()
+		},
+	}
+	runListCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to list the workflow runs of")
+	runListCmd.Flags().StringVarP(&opt.Workflow, "workflow", "w", "", "only list runs of the workflow with this ID or file name")
+	runListCmd.Flags().StringVarP(&opt.Branch, "branch", "b", "", "only list runs of this branch")
+	runListCmd.Flags().StringVarP(&opt.Status, "status", "s", "", "only list runs with this status or conclusion, eg in_progress or failure")
+	runListCmd.Flags().IntVarP(&opt.Limit, "limit", "L", 20, "maximum number of runs to list, 0 for all")
+	runListCmd.Flags().BoolVar(&asJSON, "json", false, "print the runs as JSON")
+	_ = runListCmd.MarkFlagRequired("repo")
+	return runListCmd
+}
+
+func init() {
+	runCmd.AddCommand(newRunListCmd())
+}
@@ -0,0 +1,165 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// logJobDir returns the directory holding the step logs of a job in the logs
+// archive of a run, which is named after the job without the characters file
+// names can't contain.
+func logJobDir(name string) string {
+	return strings.NewReplacer("/", "", ":", "").Replace(name)
+}
+
+// findStepLog returns the log of a step in the logs archive of a run, or nil
+// when the step didn't run. The step name in the file name is truncated, so
+// the log is found by the step number.
+func findStepLog(archive *zip.Reader, job *api.WorkflowJob, step *api.WorkflowStep) *zip.File {
+	prefix := fmt.Sprintf("%s/%d_", logJobDir(job.Name), step.Number)
+	for _, f := range archive.File {
+		if strings.HasPrefix(f.Name, prefix) && strings.HasSuffix(f.Name, ".txt") {
+			return f
+		}
+	}
+	return nil
+}
+
+// writeRunLogs writes the step logs of jobs from a run logs archive, every
+// line prefixed with the job and step name. With failedOnly only the logs of
+// failed steps are written.
+func writeRunLogs(w io.Writer, archive *zip.Reader, jobs []*api.WorkflowJob, failedOnly bool) error {
+	for _, job := range jobs {
+		for _, step := range job.Steps {
+			if failedOnly && conclusionState(step.Status, step.Conclusion) != "fail" {
+				continue
+			}
+			f := findStepLog(archive, job, step)
+			if f == nil {
+				continue
+			}
+			r, err := f.Open()
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", f.Name, err)
+			}
+			scanner := bufio.NewScanner(r)
+			scanner.Buffer(nil, 1024*1024)
+			for scanner.Scan() {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", job.Name, step.Name, scanner.Text())
+			}
+			r.Close()
+			if err := scanner.Err(); err != nil {
+				return fmt.Errorf("reading %s: %w", f.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// selectJobs returns the job with the given ID or name, or all jobs if job is
+// empty. With failedOnly only failed jobs are returned.
+func selectJobs(jobs []*api.WorkflowJob, job string, failedOnly bool) ([]*api.WorkflowJob, error) {
+	var selected []*api.WorkflowJob
+	for _, j := range jobs {
+		if job != "" && job != j.Name && job != strconv.FormatInt(j.ID, 10) {
+			continue
+		}
+		if failedOnly && conclusionState(j.Status, j.Conclusion) != "fail" {
+			continue
+		}
+		selected = append(selected, j)
+	}
+	if job != "" && len(selected) == 0 && !failedOnly {
+		return nil, fmt.Errorf("the run has no job %q", job)
+	}
+	return selected, nil
+}
+
+func newRunLogsCmd() *cobra.Command {
+	var repo string
+	var job string
+	var failedOnly bool
+	var runLogsCmd = &cobra.Command{
+		Use:   "logs <run-id>",
+		Short: "Print the logs of a workflow run",
+		Long: `Download the logs of a workflow run and print them, every line prefixed with
+the job and step it belongs to. GitHub only keeps logs for a limited time.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			id, err := parseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			jobs, err := ghApi.ListRunJobs(repo, id)
+			if err != nil {
+				return err
+			}
+			jobs, err = selectJobs(jobs, job, failedOnly)
+			if err != nil {
+				return err
+			}
+			if len(jobs) == 0 {
+				fmt.Fprintln(cmd.ErrOrStderr(), "No failed jobs")
+				return nil
+			}
+			body, err := ghApi.RunLogs(repo, id)
+			if err != nil {
+				return err
+			}
+			defer body.Close()
+			// Reading a zip archive needs random access.
+			data, err := io.ReadAll(body)
+			if err != nil {
+				return fmt.Errorf("downloading logs: %w", err)
+			}
+			archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+			if err != nil {
+				return fmt.Errorf("reading logs: %w", err)
+			}
+			return writeRunLogs(cmd.OutOrStdout(), archive, jobs, failedOnly)
+		},
+	}
+	runLogsCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the run belongs to")
+	runLogsCmd.Flags().StringVarP(&job, "job", "j", "", "only print the logs of the job with this ID or name")
+	runLogsCmd.Flags().BoolVar(&failedOnly, "failed-only", false, "only print the logs of failed steps")
+	_ = runLogsCmd.MarkFlagRequired("repo")
+	return runLogsCmd
+}
+
+func init() {
+	runCmd.AddCommand(newRunLogsCmd())
+}
@@ -0,0 +1,69 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newRunRerunCmd() *cobra.Command {
+	var repo string
+	var failed bool
+	var runRerunCmd = &cobra.Command{
+		Use:   "rerun <run-id>",
+		Short: "Rerun a completed workflow run",
+		Long: `Rerun a completed workflow run. With --failed only the failed jobs and the
+jobs depending on them are run again.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			id, err := parseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			if err := ghApi.RerunRun(repo, id, failed); err != nil {
+				return err
+			}
+			if failed {
+				fmt.Fprintf(cmd.OutOrStdout(), "%sRerunning the failed jobs of run %d\n", green, id)
+				return nil
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sRerunning run %d\n", green, id)
+			return nil
+		},
+	}
+	runRerunCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the run belongs to")
+	runRerunCmd.Flags().BoolVar(&failed, "failed", false, "only rerun the failed jobs")
+	_ = runRerunCmd.MarkFlagRequired("repo")
+	return runRerunCmd
+}
+
+func init() {
+	runCmd.AddCommand(newRunRerunCmd())
+}
@@ -0,0 +1,96 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newRunWatchCmd() *cobra.Command {
+	var repo string
+	var interval time.Duration
+	var runWatchCmd = &cobra.Command{
+		Use:   "watch <run-id>",
+		Short: "Watch a workflow run until it completes",
+		Long: `Watch a workflow run until it completes, printing its jobs whenever one of
+them progresses. The command fails when the run doesn't succeed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			if interval < time.Second {
+				return fmt.Errorf("--interval has to be at least 1s")
+			}
+			id, err := parseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			out := cmd.OutOrStdout()
+			var last []byte
+			for {
+				run, err := ghApi.GetRun(repo, id)
+				if err != nil {
+					return err
+				}
+				jobs, err := ghApi.ListRunJobs(repo, id)
+				if err != nil {
+					return err
+				}
+				var progress bytes.Buffer
+				if err := writeJobs(&progress, jobs, false); err != nil {
+					return err
+				}
+				if !bytes.Equal(progress.Bytes(), last) {
+					last = progress.Bytes()
+					fmt.Fprintf(out, "%s%s\n", reset, time.Now().Format("15:04:05"))
+					out.Write(last)
+				}
+				if run.Status == "completed" {
+					state := conclusionState(run.Status, run.Conclusion)
+					fmt.Fprintf(out, "%sRun %d completed: %s%s\n", stateColours[state], id, run.Conclusion, reset)
+					if state == "fail" {
+						cmd.SilenceUsage = true
+						return fmt.Errorf("run %d concluded with %s", id, run.Conclusion)
+					}
+					return nil
+				}
+				sleep(interval)
+			}
+		},
+	}
+	runWatchCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the run belongs to")
+	runWatchCmd.Flags().DurationVarP(&interval, "interval", "i", 5*time.Second, "time between polls, at least 1s")
+	_ = runWatchCmd.MarkFlagRequired("repo")
+	return runWatchCmd
+}
+
+func init() {
+	runCmd.AddCommand(newRunWatchCmd())
+}
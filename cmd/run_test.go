@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// newTestZip returns a zip archive with the given files.
+func newTestZip(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(content))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+const testRunJobs = `{"jobs": [
+	{"id": 1, "name": "build", "status": "completed", "conclusion": "success",
+		"started_at": "2022-03-01T10:00:00Z", "completed_at": "2022-03-01T10:01:30Z",
+		"steps": [{"number": 1, "name": "Set up job", "status": "completed", "conclusion": "success"}]},
+	{"id": 2, "name": "test / unit", "status": "completed", "conclusion": "failure",
+		"started_at": "2022-03-01T10:00:00Z", "completed_at": "2022-03-01T10:00:42Z",
+		"steps": [{"number": 1, "name": "Set up job", "status": "completed", "conclusion": "success"},
+			{"number": 2, "name": "Run go test ./...", "status": "completed", "conclusion": "failure"},
+			{"number": 3, "name": "Upload coverage", "status": "completed", "conclusion": "skipped"}]}]}`
+
+func TestWriteRunLogs(t *testing.T) {
+	data := newTestZip(t, map[string]string{
+		"0_build.txt":                   "whole log",
+		"build/1_Set up job.txt":        "Runner ready\n",
+		"test  unit/1_Set up job.txt":   "Runner ready\n",
+		"test  unit/2_Run go test .txt": "--- FAIL: TestSort\nFAIL\n",
+	})
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	jobs := []*api.WorkflowJob{
+		{Name: "build", Steps: []*api.WorkflowStep{{Number: 1, Name: "Set up job", Status: "completed", Conclusion: "success"}}},
+		{Name: "test / unit", Steps: []*api.WorkflowStep{
+			{Number: 1, Name: "Set up job", Status: "completed", Conclusion: "success"},
+			{Number: 2, Name: "Run go test ./...", Status: "completed", Conclusion: "failure"},
+			{Number: 3, Name: "Upload coverage", Status: "completed", Conclusion: "skipped"},
+		}},
+	}
+	buff := new(bytes.Buffer)
+	if err := writeRunLogs(buff, archive, jobs, false); err != nil {
+		t.Fatal(err)
+	}
+	want := "build\tSet up job\tRunner ready\n" +
+		"test / unit\tSet up job\tRunner ready\n" +
+		"test / unit\tRun go test ./...\t--- FAIL: TestSort\n" +
+		"test / unit\tRun go test ./...\tFAIL\n"
+	if got := buff.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	buff.Reset()
+	if err := writeRunLogs(buff, archive, jobs, true); err != nil {
+		t.Fatal(err)
+	}
+	want = "test / unit\tRun go test ./...\t--- FAIL: TestSort\n" +
+		"test / unit\tRun go test ./...\tFAIL\n"
+	if got := buff.String(); got != want {
+		t.Errorf("failed only got %q, want %q", got, want)
+	}
+}
+
+func TestRunViewCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		body := ""
+		switch req.URL.Path {
+		case "/repos/TheAlgorithms/Go/actions/runs/7":
+			body = `{"id": 7, "name": "CI", "display_title": "Add heap sort", "run_number": 12, "run_attempt": 1,
+				"event": "push", "status": "completed", "conclusion": "failure", "head_branch": "main",
+				"head_sha": "abcdef123456", "html_url": "https://github.com/TheAlgorithms/Go/actions/runs/7"}`
+		case "/repos/TheAlgorithms/Go/actions/runs/7/jobs":
+			body = testRunJobs
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"run", "view", "7", "-r", "TheAlgorithms/Go"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := red + "Run: CI #12 (attempt 1)\n" +
+		red + "Title: Add heap sort\n" +
+		red + "State: fail\n" +
+		red + "Branch: main\n" +
+		red + "Event: push\n" +
+		red + "Commit: abcdef1\n" +
+		red + "URL: https://github.com/TheAlgorithms/Go/actions/runs/7" + reset + "\n" +
+		"\n" +
+		green + "pass        build                1m30s" + reset + "\n" +
+		green + "  pass      1 Set up job         " + reset + "\n" +
+		red + "fail        test / unit          42s" + reset + "\n" +
+		green + "  pass      1 Set up job         " + reset + "\n" +
+		red + "  fail      2 Run go test ./...  " + reset + "\n" +
+		gray + "  skipping  3 Upload coverage    " + reset + "\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestRunWatchCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	polls := 0
+	client = newTestClient(func(req *http.Request) *http.Response {
+		body := ""
+		switch req.URL.Path {
+		case "/repos/TheAlgorithms/Go/actions/runs/7":
+			polls++
+			body = `{"id": 7, "status": "in_progress"}`
+			if polls > 1 {
+				body = `{"id": 7, "status": "completed", "conclusion": "failure"}`
+			}
+		case "/repos/TheAlgorithms/Go/actions/runs/7/jobs":
+			body = testRunJobs
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	oldSleep := sleep
+	defer func() { sleep = oldSleep }()
+	var slept []time.Duration
+	sleep = func(d time.Duration) { slept = append(slept, d) }
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"run", "watch", "7", "-r", "TheAlgorithms/Go", "--interval", "0s"})
+	err := rootCmd.Execute()
+	if err == nil || err.Error() != "--interval has to be at least 1s" || polls != 0 {
+		t.Fatalf("watching with a zero interval = %v after %d polls", err, polls)
+	}
+	buff.Reset()
+	rootCmd.SetArgs([]string{"run", "watch", "7", "-r", "TheAlgorithms/Go", "--interval", "2s"})
+	err = rootCmd.Execute()
+	if err == nil || err.Error() != "run 7 concluded with failure" {
+		t.Fatalf("watching a failed run = %v", err)
+	}
+	got := buff.String()
+	if polls != 2 || strings.Count(got, "test / unit") != 1 || !strings.HasSuffix(got, red+"Run 7 completed: failure"+reset+"\n") {
+		t.Errorf("after %d polls got %q", polls, got)
+	}
+	if len(slept) != 1 || slept[0] != 2*time.Second {
+		t.Errorf("slept %v between polls, want 2s once", slept)
+	}
+}
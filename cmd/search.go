@@ -0,0 +1,66 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// searchCmd represents the search command
+var searchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Search issues or PRs across repositories",
+	Long: `Search issues or PRs across repositories using the GitHub search syntax.
+
+Every qualifier GitHub supports can be used, eg is:open, label:bug, org:cli,
+involves:@me, review-requested:@me or created:2022-01-01..2022-03-31.`,
+}
+
+// runSearch searches for the issues or PRs selected by kind ("issue" or "pr")
+// and prints them like the list commands do.
+func runSearch(cmd *cobra.Command, kind string, args []string, limit int, asJSON bool) error {
+	query := fmt.Sprintf("is:%s %s", kind, strings.Join(args, " "))
+	ghApi := api.NewApi(client)
+	issues, incomplete, err := ghApi.SearchIssues(query, limit)
+	if err != nil {
+		return err
+	}
+	if incomplete {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Warning: GitHub timed out before finding every match, the results are incomplete")
+	}
+	if asJSON {
+		return printJSON(cmd.OutOrStdout(), issues)
+	}
+	for _, issue := range issues {
+		printIssue(cmd.OutOrStdout(), issue)
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(searchCmd)
+}
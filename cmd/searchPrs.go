@@ -0,0 +1,50 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func newSearchPrsCmd() *cobra.Command {
+	var limit int
+	var asJSON bool
+	var searchPrsCmd = &cobra.Command{
+		Use:   "prs <query>...",
+		Short: "Search PRs across repositories",
+		Long: `Search PRs across repositories, eg
+
+  ghcli search prs org:cli review-requested:@me is:open`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSearch(cmd, "pr", args, limit, asJSON)
+		},
+	}
+	searchPrsCmd.Flags().IntVarP(&limit, "limit", "L", 30, "maximum number of PRs to retrieve, 0 for as many as GitHub allows")
+	searchPrsCmd.Flags().BoolVar(&asJSON, "json", false, "print the PRs as JSON")
+	return searchPrsCmd
+}
+
+func init() {
+	searchCmd.AddCommand(newSearchPrsCmd())
+}
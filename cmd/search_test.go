@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestSearchPrsCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	errBuff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		query := req.URL.Query()
+		if req.URL.Path != "/search/issues" || query.Get("q") != "is:pr org:TheAlgorithms review-requested:@me" || query.Get("per_page") != "30" {
+			t.Errorf("search URL = %v", req.URL)
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body: ioutil.NopCloser(bytes.NewBufferString(`{"total_count": 1, "incomplete_results": true, "items": [
+	{
+		"number": 1,
+		"title": "Test PR 1",
+		"body": "Test PR 1 body",
+		"html_url": "https://sample.url",
+		"state": "open"
+	}
+]}`)),
+			Header: make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetErr(errBuff)
+	defer rootCmd.SetErr(nil)
+	rootCmd.SetArgs([]string{"search", "prs", "org:TheAlgorithms", "review-requested:@me"})
+	err := rootCmd.Execute()
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	got := buff.String()
+	want := "\x1b[32mStatus: open\n\x1b[32mTitle: Test PR 1\n\x1b[32mURL: https://sample.url\n\x1b[32mNumber: 1\n\x1b[32mBody: Test PR 1 body\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if errBuff.Len() == 0 {
+		t.Errorf("no warning about incomplete results was printed")
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// secretCmd represents the secret command
+var secretCmd = &cobra.Command{
+	Use:   "secret",
+	Short: "Manage GitHub Actions secrets",
+	Long: `List, set and delete the Actions secrets of a repository, an environment of a
+repository or an organisation. Values are encrypted before they leave this
+machine.`,
+}
+
+// addScopeFlags adds the flags selecting where secrets or variables are kept.
+// kind is what is kept, secrets or variables.
+func addScopeFlags(cmd *cobra.Command, scope *api.SecretScope, kind string) {
+	cmd.Flags().StringVarP(&scope.Repo, "repo", "r", "", "repository the "+kind+" belong to")
+	cmd.Flags().StringVarP(&scope.Environment, "env", "e", "", "environment of the repository the "+kind+" belong to")
+	cmd.Flags().StringVarP(&scope.Org, "org", "o", "", "organisation the "+kind+" belong to, instead of a repository")
+}
+
+func checkScope(scope api.SecretScope) error {
+	switch {
+	case scope.Repo == "" && scope.Org == "":
+		return fmt.Errorf("repo or org is required")
+	case scope.Repo != "" && scope.Org != "":
+		return fmt.Errorf("only one of repo and org can be given")
+	case scope.Org != "" && scope.Environment != "":
+		return fmt.Errorf("environments belong to a repository, not to an organisation")
+	}
+	return nil
+}
+
+func describeScope(scope api.SecretScope) string {
+	switch {
+	case scope.Org != "":
+		return "organisation " + scope.Org
+	case scope.Environment != "":
+		return fmt.Sprintf("environment %s of %s", scope.Environment, scope.Repo)
+	}
+	return scope.Repo
+}
+
+// namedValue is a secret or variable to set.
+type namedValue struct {
+	name  string
+	value string
+}
+
+// parseEnvFile reads KEY=value lines as written in .env files. Blank lines
+// and comments are skipped, and values may be quoted.
+func parseEnvFile(r io.Reader) ([]namedValue, error) {
+	var values []namedValue
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		name, value, ok := strings.Cut(line, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("line %d: expected NAME=value", n)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		values = append(values, namedValue{name, value})
+	}
+	return values, scanner.Err()
+}
+
+// readValues returns the values set by secret set or variable set: those of
+// envFile, or the value of the single name given as argument from body or,
+// when body isn't given, from standard input.
+func readValues(cmd *cobra.Command, args []string, envFile string) ([]namedValue, error) {
+	if envFile != "" {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("no name can be given with --env-file")
+		}
+		f, err := os.Open(envFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		values, err := parseEnvFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", envFile, err)
+		}
+		return values, nil
+	}
+	if len(args) != 1 {
+		return nil, fmt.Errorf("a name or --env-file is required")
+	}
+	if cmd.Flags().Changed("body") {
+		body, _ := cmd.Flags().GetString("body")
+		return []namedValue{{args[0], body}}, nil
+	}
+	data, err := io.ReadAll(cmd.InOrStdin())
+	if err != nil {
+		return nil, fmt.Errorf("reading value: %w", err)
+	}
+	value := strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r")
+	return []namedValue{{args[0], value}}, nil
+}
+
+func init() {
+	rootCmd.AddCommand(secretCmd)
+}
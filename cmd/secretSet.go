@@ -0,0 +1,74 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newSecretSetCmd() *cobra.Command {
+	var scope api.SecretScope
+	var envFile, visibility string
+	var secretSetCmd = &cobra.Command{
+		Use:   "set [<name>]",
+		Short: "Create or update secrets",
+		Long: `Create or update the secret called name with the value given by --body or, when
+--body isn't given, read from standard input. With --env-file every NAME=value
+line of the file is set instead. Values are encrypted with the public key of
+the repository, environment or organisation before they are sent.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkScope(scope); err != nil {
+				return err
+			}
+			values, err := readValues(cmd, args, envFile)
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			key, err := ghApi.GetSecretsPublicKey(scope)
+			if err != nil {
+				return err
+			}
+			for _, v := range values {
+				if err := ghApi.SetSecret(scope, key, v.name, []byte(v.value), visibility); err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%sSet secret %s for %s\n", green, v.name, describeScope(scope))
+			}
+			return nil
+		},
+	}
+	addScopeFlags(secretSetCmd, &scope, "secrets")
+	secretSetCmd.Flags().StringP("body", "b", "", "value of the secret")
+	secretSetCmd.Flags().StringVarP(&envFile, "env-file", "f", "", "file of NAME=value lines to set")
+	secretSetCmd.Flags().StringVarP(&visibility, "visibility", "v", "private", "repositories of the organisation which can use the secret: all, private or selected")
+	return secretSetCmd
+}
+
+func init() {
+	secretCmd.AddCommand(newSecretSetCmd())
+}
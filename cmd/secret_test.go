@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestParseEnvFile(t *testing.T) {
+	got, err := parseEnvFile(strings.NewReader("# deploy\nTOKEN=abc=def\n\nexport REGION = \"eu west\"\nNAME='x'\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []namedValue{{"TOKEN", "abc=def"}, {"REGION", "eu west"}, {"NAME", "x"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseEnvFile() = %v, want %v", got, want)
+	}
+	if _, err := parseEnvFile(strings.NewReader("TOKEN\n")); err == nil || err.Error() != "line 1: expected NAME=value" {
+		t.Errorf("parseEnvFile() of a line without a value = %v", err)
+	}
+}
+
+func TestSecretSetCmd(t *testing.T) {
+	public, private, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := make(map[string]string)
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	client = newTestClient(func(req *http.Request) *http.Response {
+		prefix := "/repos/TheAlgorithms/Go/environments/production/secrets/"
+		switch {
+		case req.URL.Path == prefix+"public-key":
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"key_id": "42", "key": "` + base64.StdEncoding.EncodeToString(public[:]) + `"}`)),
+				Header:     make(http.Header),
+			}
+		case req.Method == "PUT" && strings.HasPrefix(req.URL.Path, prefix):
+			var body map[string]string
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			sealed, _ := base64.StdEncoding.DecodeString(body["encrypted_value"])
+			value, ok := box.OpenAnonymous(nil, sealed, public, private)
+			if !ok {
+				t.Errorf("secret can't be decrypted")
+			}
+			set[strings.TrimPrefix(req.URL.Path, prefix)] = string(value)
+			return &http.Response{
+				StatusCode: 201,
+				Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+				Header:     make(http.Header),
+			}
+		}
+		t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		return nil
+	})
+	envFile := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(envFile, []byte("TOKEN=abc\nREGION=eu\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"secret", "set", "-r", "TheAlgorithms/Go", "-e", "production", "--env-file", envFile})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	rootCmd.SetIn(strings.NewReader("hunter2\n"))
+	rootCmd.SetArgs([]string{"secret", "set", "PASSWORD", "-r", "TheAlgorithms/Go", "-e", "production", "--env-file", ""})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	rootCmd.SetIn(nil)
+	if want := map[string]string{"TOKEN": "abc", "REGION": "eu", "PASSWORD": "hunter2"}; !reflect.DeepEqual(set, want) {
+		t.Errorf("set secrets %v, want %v", set, want)
+	}
+	want := green + "Set secret TOKEN for environment production of TheAlgorithms/Go\n" +
+		green + "Set secret REGION for environment production of TheAlgorithms/Go\n" +
+		green + "Set secret PASSWORD for environment production of TheAlgorithms/Go\n"
+	if got := buff.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
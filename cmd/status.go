@@ -26,13 +26,24 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var statusOrg string
+
 // statusCmd represents the status command
 var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Gives status of the requested service - pr or issue",
-	Long:  `Gives status of the requested service - pr or issues`,
+	Long: `Gives status of the requested service - pr or issues
+
+Without a subcommand it shows a dashboard of the PRs and issues which need your
+attention: PRs assigned to you or awaiting your review, your open PRs along
+with the state of their checks, issues assigned to you and recent mentions.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDashboard(cmd, statusOrg)
+	},
 }
 
 func init() {
+	statusCmd.Flags().StringVar(&statusOrg, "org", "", "only show the dashboard for repositories of this organisation")
 	rootCmd.AddCommand(statusCmd)
 }
@@ -0,0 +1,65 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newVariableListCmd() *cobra.Command {
+	var scope api.SecretScope
+	var asJSON bool
+	var variableListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the variables of a repository, environment or organisation",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkScope(scope); err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			variables, err := ghApi.ListVariables(scope)
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				return printJSON(cmd.OutOrStdout(), variables)
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			for _, variable := range variables {
+				fmt.Fprintf(w, "%s\t%s\tupdated %s\t%s\n", variable.Name, variable.Value, variable.UpdatedAt.Format("2006-01-02"), variable.Visibility)
+			}
+			return w.Flush()
+		},
+	}
+	addScopeFlags(variableListCmd, &scope, "variables")
+	variableListCmd.Flags().BoolVar(&asJSON, "json", false, "print the variables as JSON")
+	return variableListCmd
+}
+
+func init() {
+	variableCmd.AddCommand(newVariableListCmd())
+}
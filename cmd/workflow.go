@@ -0,0 +1,73 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// workflowCmd represents the workflow command
+var workflowCmd = &cobra.Command{
+	Use:   "workflow",
+	Short: "Manage GitHub Actions workflows",
+	Long:  `List, view, enable, disable and run GitHub Actions workflows.`,
+}
+
+// findWorkflow returns the workflow of repo with the given ID, name or file
+// name, eg ci.yml.
+func findWorkflow(ghApi *api.API, repo, arg string) (*api.Workflow, error) {
+	workflows, err := ghApi.ListWorkflows(repo)
+	if err != nil {
+		return nil, err
+	}
+	var matches []*api.Workflow
+	for _, workflow := range workflows {
+		if strconv.FormatInt(workflow.ID, 10) == arg || path.Base(workflow.Path) == arg || workflow.Path == arg {
+			return workflow, nil
+		}
+		if strings.EqualFold(workflow.Name, arg) {
+			matches = append(matches, workflow)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("%s has no workflow %q", repo, arg)
+	case 1:
+		return matches[0], nil
+	}
+	files := make([]string, len(matches))
+	for i, workflow := range matches {
+		files[i] = path.Base(workflow.Path)
+	}
+	return nil, fmt.Errorf("several workflows are called %q, use the file name instead: %s", arg, strings.Join(files, ", "))
+}
+
+func init() {
+	rootCmd.AddCommand(workflowCmd)
+}
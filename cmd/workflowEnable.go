@@ -0,0 +1,90 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+// setWorkflowEnabled enables or disables the workflow given by arg.
+func setWorkflowEnabled(cmd *cobra.Command, repo, arg string, enable bool) error {
+	ghApi := api.NewApi(client)
+	workflow, err := findWorkflow(ghApi, repo, arg)
+	if err != nil {
+		return err
+	}
+	if err := ghApi.SetWorkflowEnabled(repo, strconv.FormatInt(workflow.ID, 10), enable); err != nil {
+		return err
+	}
+	if enable {
+		fmt.Fprintf(cmd.OutOrStdout(), "%sEnabled workflow %s\n", green, workflow.Name)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "%sDisabled workflow %s\n", red, workflow.Name)
+	}
+	return nil
+}
+
+func newWorkflowEnableCmd() *cobra.Command {
+	var repo string
+	var workflowEnableCmd = &cobra.Command{
+		Use:   "enable <workflow>",
+		Short: "Enable a workflow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			return setWorkflowEnabled(cmd, repo, args[0], true)
+		},
+	}
+	workflowEnableCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the workflow belongs to")
+	_ = workflowEnableCmd.MarkFlagRequired("repo")
+	return workflowEnableCmd
+}
+
+func newWorkflowDisableCmd() *cobra.Command {
+	var repo string
+	var workflowDisableCmd = &cobra.Command{
+		Use:   "disable <workflow>",
+		Short: "Disable a workflow so that no event triggers it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			return setWorkflowEnabled(cmd, repo, args[0], false)
+		},
+	}
+	workflowDisableCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the workflow belongs to")
+	_ = workflowDisableCmd.MarkFlagRequired("repo")
+	return workflowDisableCmd
+}
+
+func init() {
+	workflowCmd.AddCommand(newWorkflowEnableCmd())
+	workflowCmd.AddCommand(newWorkflowDisableCmd())
+}
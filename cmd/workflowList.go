@@ -0,0 +1,70 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newWorkflowListCmd() *cobra.Command {
+	var repo string
+	var asJSON bool
+	var workflowListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the workflows of the stated repository",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			workflows, err := ghApi.ListWorkflows(repo)
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				return printJSON(cmd.OutOrStdout(), workflows)
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			for _, workflow := range workflows {
+				colour := green
+				if workflow.State != "active" {
+					colour = gray
+				}
+				fmt.Fprintf(w, "%s%s\t%s\t%d\t%s%s\n", colour, workflow.Name, workflow.State, workflow.ID, workflow.Path, reset)
+			}
+			return w.Flush()
+		},
+	}
+	workflowListCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository to list the workflows of")
+	workflowListCmd.Flags().BoolVar(&asJSON, "json", false, "print the workflows as JSON")
+	_ = workflowListCmd.MarkFlagRequired("repo")
+	return workflowListCmd
+}
+
+func init() {
+	workflowCmd.AddCommand(newWorkflowListCmd())
+}
@@ -0,0 +1,199 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+	"gopkg.in/yaml.v3"
+)
+
+// workflowInput is an input of the workflow_dispatch trigger of a workflow.
+type workflowInput struct {
+	Description string   `yaml:"description"`
+	Required    bool     `yaml:"required"`
+	Default     string   `yaml:"default"`
+	Type        string   `yaml:"type"`
+	Options     []string `yaml:"options"`
+}
+
+// parseDispatchInputs returns the inputs declared by the workflow_dispatch
+// trigger of a workflow file. ok is false if the workflow has no such
+// trigger, which may be given as a string, a list or a map of events.
+func parseDispatchInputs(content []byte) (inputs map[string]workflowInput, ok bool, err error) {
+	var file struct {
+		On yaml.Node `yaml:"on"`
+	}
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, false, fmt.Errorf("parsing workflow: %w", err)
+	}
+	on := &file.On
+	switch on.Kind {
+	case yaml.ScalarNode:
+		return nil, on.Value == "workflow_dispatch", nil
+	case yaml.SequenceNode:
+		for _, event := range on.Content {
+			if event.Value == "workflow_dispatch" {
+				return nil, true, nil
+			}
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(on.Content); i += 2 {
+			if on.Content[i].Value != "workflow_dispatch" {
+				continue
+			}
+			var trigger struct {
+				Inputs map[string]workflowInput `yaml:"inputs"`
+			}
+			if err := on.Content[i+1].Decode(&trigger); err != nil {
+				return nil, false, fmt.Errorf("parsing workflow_dispatch inputs: %w", err)
+			}
+			return trigger.Inputs, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// validateInputs checks the given inputs against those declared by a
+// workflow before it is dispatched, as GitHub only reports the first problem.
+func validateInputs(declared map[string]workflowInput, given map[string]string) error {
+	var problems []string
+	for name, value := range given {
+		input, ok := declared[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("unknown input %q", name))
+			continue
+		}
+		switch input.Type {
+		case "boolean":
+			if value != "true" && value != "false" {
+				problems = append(problems, fmt.Sprintf("input %q has to be true or false", name))
+			}
+		case "number":
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				problems = append(problems, fmt.Sprintf("input %q has to be a number", name))
+			}
+		case "choice":
+			valid := false
+			for _, option := range input.Options {
+				valid = valid || option == value
+			}
+			if !valid {
+				problems = append(problems, fmt.Sprintf("input %q has to be one of %s", name, strings.Join(input.Options, ", ")))
+			}
+		}
+	}
+	for name, input := range declared {
+		if _, ok := given[name]; !ok && input.Required && input.Default == "" {
+			problems = append(problems, fmt.Sprintf("input %q is required", name))
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("invalid inputs: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// parseInputFlags splits the key=value pairs given with -f.
+func parseInputFlags(fields []string) (map[string]string, error) {
+	inputs := make(map[string]string, len(fields))
+	for _, field := range fields {
+		name, value, ok := strings.Cut(field, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid input %q, expected name=value", field)
+		}
+		inputs[name] = value
+	}
+	return inputs, nil
+}
+
+func newWorkflowRunCmd() *cobra.Command {
+	var repo, ref string
+	var fields []string
+	var workflowRunCmd = &cobra.Command{
+		Use:   "run <workflow>",
+		Short: "Run a workflow with a workflow_dispatch trigger",
+		Long: `Run a workflow, given by its ID, name or file name, on --ref, by default the
+default branch. Inputs are given as -f name=value and are checked against the
+inputs the workflow_dispatch trigger of the workflow declares on that ref.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			inputs, err := parseInputFlags(fields)
+			if err != nil {
+				return err
+			}
+			ghApi := api.NewApi(client)
+			workflow, err := findWorkflow(ghApi, repo, args[0])
+			if err != nil {
+				return err
+			}
+			if ref == "" {
+				r, err := ghApi.GetRepo(repo)
+				if err != nil {
+					return err
+				}
+				ref = r.GetDefaultBranch()
+			}
+			content, found, err := ghApi.GetFileContent(repo, workflow.Path, ref)
+			if err != nil {
+				return err
+			}
+			if !found {
+				return fmt.Errorf("%s doesn't exist on %s", workflow.Path, ref)
+			}
+			declared, ok, err := parseDispatchInputs([]byte(content))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("workflow %s can't be run, it has no workflow_dispatch trigger on %s", workflow.Name, ref)
+			}
+			if err := validateInputs(declared, inputs); err != nil {
+				return err
+			}
+			if err := ghApi.DispatchWorkflow(repo, strconv.FormatInt(workflow.ID, 10), ref, inputs); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%sStarted workflow %s on %s\n", green, workflow.Name, ref)
+			return nil
+		},
+	}
+	workflowRunCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the workflow belongs to")
+	workflowRunCmd.Flags().StringVar(&ref, "ref", "", "branch or tag to run the workflow on")
+	workflowRunCmd.Flags().StringArrayVarP(&fields, "field", "f", nil, "input of the workflow as name=value")
+	_ = workflowRunCmd.MarkFlagRequired("repo")
+	return workflowRunCmd
+}
+
+func init() {
+	workflowCmd.AddCommand(newWorkflowRunCmd())
+}
@@ -0,0 +1,97 @@
+/*
+Copyright © 2022 Tajmeet Singh <tjgurwara99@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tjgurwara99/ghcli/api"
+)
+
+func newWorkflowViewCmd() *cobra.Command {
+	var repo, ref string
+	var limit int
+	var workflowViewCmd = &cobra.Command{
+		Use:   "view <workflow>",
+		Short: "View a workflow with its recent runs and YAML",
+		Long: `View a workflow, given by its ID, name or file name, with its recent runs and
+its YAML on --ref, by default the default branch. Output to a terminal is shown
+through $PAGER, or less when PAGER isn't set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if repo == "" {
+				return fmt.Errorf("repo is required")
+			}
+			ghApi := api.NewApi(client)
+			workflow, err := findWorkflow(ghApi, repo, args[0])
+			if err != nil {
+				return err
+			}
+			id := strconv.FormatInt(workflow.ID, 10)
+			runs, err := ghApi.ListRuns(repo, &api.RunListOptions{Workflow: id, Limit: limit})
+			if err != nil {
+				return err
+			}
+			content, found, err := ghApi.GetFileContent(repo, workflow.Path, ref)
+			if err != nil {
+				return err
+			}
+
+			out, wait, err := startPager(cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "%sName: %s\n", green, workflow.Name)
+			fmt.Fprintf(out, "%sID: %d\n", green, workflow.ID)
+			fmt.Fprintf(out, "%sFile: %s\n", green, workflow.Path)
+			fmt.Fprintf(out, "%sState: %s\n", green, workflow.State)
+			fmt.Fprintf(out, "%sURL: %s%s\n", green, workflow.HTMLURL, reset)
+			fmt.Fprintln(out, "\nRecent runs:")
+			w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+			for _, run := range runs {
+				state := conclusionState(run.Status, run.Conclusion)
+				fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\t%d\t%s%s\n", stateColours[state], state, run.DisplayTitle,
+					run.HeadBranch, run.Event, run.ID, run.CreatedAt.Format("2006-01-02 15:04"), reset)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			if found {
+				fmt.Fprintf(out, "\n%s%s%s", gray, content, reset)
+			}
+			return wait()
+		},
+	}
+	workflowViewCmd.Flags().StringVarP(&repo, "repo", "r", "", "repository the workflow belongs to")
+	workflowViewCmd.Flags().StringVar(&ref, "ref", "", "branch or tag to show the YAML of")
+	workflowViewCmd.Flags().IntVarP(&limit, "limit", "L", 5, "number of recent runs to show")
+	_ = workflowViewCmd.MarkFlagRequired("repo")
+	return workflowViewCmd
+}
+
+func init() {
+	workflowCmd.AddCommand(newWorkflowViewCmd())
+}
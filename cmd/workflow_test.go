@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testWorkflow = `name: Deploy
+on:
+  push:
+    branches: [main]
+  workflow_dispatch:
+    inputs:
+      environment:
+        description: Where to deploy to
+        required: true
+        type: choice
+        options: [staging, production]
+      dry-run:
+        type: boolean
+        default: "false"
+      replicas:
+        type: number
+        required: true
+        default: "2"
+jobs: {}
+`
+
+func TestParseDispatchInputs(t *testing.T) {
+	for _, on := range []string{"workflow_dispatch", "[push, workflow_dispatch]", "{workflow_dispatch: }"} {
+		inputs, ok, err := parseDispatchInputs([]byte("on: " + on + "\n"))
+		if err != nil || !ok || len(inputs) != 0 {
+			t.Errorf("parseDispatchInputs(on: %s) = %v, %v, %v", on, inputs, ok, err)
+		}
+	}
+	if _, ok, _ := parseDispatchInputs([]byte("on: [push]\n")); ok {
+		t.Errorf("parseDispatchInputs() of a workflow without workflow_dispatch is dispatchable")
+	}
+	inputs, ok, err := parseDispatchInputs([]byte(testWorkflow))
+	if err != nil || !ok {
+		t.Fatalf("parseDispatchInputs() = %v, %v", ok, err)
+	}
+	want := workflowInput{Description: "Where to deploy to", Required: true, Type: "choice", Options: []string{"staging", "production"}}
+	if len(inputs) != 3 || !reflect.DeepEqual(inputs["environment"], want) {
+		t.Errorf("parseDispatchInputs() = %v", inputs)
+	}
+}
+
+func TestValidateInputs(t *testing.T) {
+	declared, _, _ := parseDispatchInputs([]byte(testWorkflow))
+	if err := validateInputs(declared, map[string]string{"environment": "staging", "dry-run": "true", "replicas": "3"}); err != nil {
+		t.Errorf("validateInputs() of valid inputs = %v", err)
+	}
+	err := validateInputs(declared, map[string]string{"dry-run": "yes", "replicas": "many", "region": "eu"})
+	want := `invalid inputs: input "dry-run" has to be true or false; input "environment" is required; input "replicas" has to be a number; unknown input "region"`
+	if err == nil || err.Error() != want {
+		t.Errorf("validateInputs() = %v, want %v", err, want)
+	}
+	err = validateInputs(declared, map[string]string{"environment": "dev"})
+	if err == nil || err.Error() != `invalid inputs: input "environment" has to be one of staging, production` {
+		t.Errorf("validateInputs() of an invalid choice = %v", err)
+	}
+}
+
+func TestWorkflowRunCmd(t *testing.T) {
+	buff := new(bytes.Buffer)
+	oldClient := client
+	defer func() { client = oldClient }()
+	dispatched := false
+	client = newTestClient(func(req *http.Request) *http.Response {
+		status, body := 200, ""
+		switch req.URL.Path {
+		case "/repos/TheAlgorithms/Go/actions/workflows":
+			body = `{"workflows": [{"id": 5, "name": "Deploy", "path": ".github/workflows/deploy.yml"}]}`
+		case "/repos/TheAlgorithms/Go/contents/.github/workflows/deploy.yml":
+			if req.URL.Query().Get("ref") != "release" {
+				t.Errorf("workflow requested on %v", req.URL)
+			}
+			body = `{"type": "file", "encoding": "base64", "content": "` + base64.StdEncoding.EncodeToString([]byte(testWorkflow)) + `"}`
+		case "/repos/TheAlgorithms/Go/actions/workflows/5/dispatches":
+			dispatched = true
+			status = 204
+		default:
+			t.Errorf("unexpected request %v %v", req.Method, req.URL)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+	rootCmd.SetOut(buff)
+	rootCmd.SetArgs([]string{"workflow", "run", "deploy.yml", "-r", "TheAlgorithms/Go", "--ref", "release", "-f", "environment=qa"})
+	if err := rootCmd.Execute(); err == nil || dispatched {
+		t.Fatalf("running with an invalid input = %v, dispatched %v", err, dispatched)
+	}
+	buff.Reset()
+	rootCmd.SetArgs([]string{"workflow", "run", "deploy", "-r", "TheAlgorithms/Go", "--ref", "release", "-f", "environment=staging"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got, want := buff.String(), green+"Started workflow Deploy on release\n"; !dispatched || got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
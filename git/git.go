@@ -0,0 +1,252 @@
+// Package git runs git commands in a local checkout.
+package git
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+type Git struct {
+	dir string
+}
+
+// New returns a Git which runs commands in dir. An empty dir means the
+// current working directory.
+func New(dir string) *Git {
+	return &Git{
+		dir: dir,
+	}
+}
+
+// In returns a Git which runs commands in dir, which is relative to the
+// directory g runs commands in unless it is absolute.
+func (g *Git) In(dir string) *Git {
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(g.dir, dir)
+	}
+	return New(dir)
+}
+
+// Commit is a single commit as reported by git log.
+type Commit struct {
+	SHA     string
+	Subject string
+	Body    string
+}
+
+// run runs git with args and returns its trimmed standard output. The
+// error includes whatever git printed to standard error.
+func (g *Git) run(args ...string) (string, error) {
+	return g.runEnv(nil, args...)
+}
+
+// runEnv is run with env added to the environment of git.
+func (g *Git) runEnv(env []string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Dir = g.dir
+	if env != nil {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return "", fmt.Errorf("git %s: %s", args[0], msg)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// config returns the value of key, or an empty string when it isn't set.
+func (g *Git) config(key string) (string, error) {
+	cmd := exec.Command("git", "config", "--get", key)
+	cmd.Dir = g.dir
+	out, err := cmd.Output()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		// git config exits with 1 when the key isn't set.
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("git config: %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// CurrentBranch returns the name of the checked out branch.
+func (g *Git) CurrentBranch() (string, error) {
+	branch, err := g.run("symbolic-ref", "--quiet", "--short", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("not on a branch - is HEAD detached? %w", err)
+	}
+	return branch, nil
+}
+
+// Upstream returns the remote and branch which branch tracks. Both are empty
+// when branch has no upstream.
+func (g *Git) Upstream(branch string) (remote, remoteBranch string, err error) {
+	remote, err = g.config("branch." + branch + ".remote")
+	if err != nil || remote == "" {
+		return "", "", err
+	}
+	merge, err := g.config("branch." + branch + ".merge")
+	if err != nil {
+		return "", "", err
+	}
+	return remote, strings.TrimPrefix(merge, "refs/heads/"), nil
+}
+
+// RevParse returns the SHA ref points to.
+func (g *Git) RevParse(ref string) (string, error) {
+	return g.run("rev-parse", "--verify", "--quiet", ref+"^{commit}")
+}
+
+// Push pushes branch to the branch of the same name on remote. With
+// setUpstream the local branch starts tracking the pushed branch.
+func (g *Git) Push(remote, branch string, setUpstream bool) error {
+	args := []string{"push"}
+	if setUpstream {
+		args = append(args, "--set-upstream")
+	}
+	args = append(args, remote, "HEAD:refs/heads/"+branch)
+	_, err := g.run(args...)
+	return err
+}
+
+// Commits returns the commits reachable from to but not from, oldest first.
+func (g *Git) Commits(from, to string) ([]Commit, error) {
+	out, err := g.run("log", "--reverse", "--format=%H%x00%s%x00%b%x1e", from+".."+to)
+	if err != nil {
+		return nil, err
+	}
+	var commits []Commit
+	for _, entry := range strings.Split(out, "\x1e") {
+		fields := strings.SplitN(strings.TrimSpace(entry), "\x00", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		commits = append(commits, Commit{
+			SHA:     fields[0],
+			Subject: fields[1],
+			Body:    strings.TrimSpace(fields[2]),
+		})
+	}
+	return commits, nil
+}
+
+// TopLevel returns the root directory of the checkout.
+func (g *Git) TopLevel() (string, error) {
+	return g.run("rev-parse", "--show-toplevel")
+}
+
+// HasLocalChanges reports whether tracked files have uncommitted changes.
+func (g *Git) HasLocalChanges() (bool, error) {
+	out, err := g.run("status", "--porcelain", "--untracked-files=no")
+	if err != nil {
+		return false, err
+	}
+	return out != "", nil
+}
+
+// BranchExists reports whether there is a local branch called branch.
+func (g *Git) BranchExists(branch string) bool {
+	_, err := g.run("show-ref", "--verify", "--quiet", "refs/heads/"+branch)
+	return err == nil
+}
+
+// Fetch fetches refspecs from remote, which may be a remote name or URL. The
+// first fetched ref is available as FETCH_HEAD afterwards.
+func (g *Git) Fetch(remote string, refspecs ...string) error {
+	_, err := g.run(append([]string{"fetch", remote}, refspecs...)...)
+	return err
+}
+
+// Checkout checks out an existing branch.
+func (g *Git) Checkout(branch string) error {
+	_, err := g.run("checkout", branch)
+	return err
+}
+
+// CheckoutNewBranch creates branch at startPoint and checks it out.
+func (g *Git) CheckoutNewBranch(branch, startPoint string) error {
+	_, err := g.run("checkout", "-b", branch, startPoint)
+	return err
+}
+
+// FastForward fast forwards the current branch to ref, failing if the
+// branch has diverged from it.
+func (g *Git) FastForward(ref string) error {
+	_, err := g.run("merge", "--ff-only", ref)
+	return err
+}
+
+// ResetHard points the current branch at ref, discarding local commits and
+// changes.
+func (g *Git) ResetHard(ref string) error {
+	_, err := g.run("reset", "--hard", ref)
+	return err
+}
+
+// SetUpstream makes branch track mergeRef, eg "refs/heads/main", of remote,
+// which may be a remote name or URL. git pull and git push use it.
+func (g *Git) SetUpstream(branch, remote, mergeRef string) error {
+	if _, err := g.run("config", "branch."+branch+".remote", remote); err != nil {
+		return err
+	}
+	_, err := g.run("config", "branch."+branch+".merge", mergeRef)
+	return err
+}
+
+// DeleteBranch deletes a local branch. git refuses to delete it unless it is
+// merged into its upstream, or HEAD when it has none.
+func (g *Git) DeleteBranch(branch string) error {
+	_, err := g.run("branch", "-d", branch)
+	return err
+}
+
+// Clone clones url into dir, relative to the directory g runs commands in.
+func (g *Git) Clone(url, dir string) error {
+	_, err := g.run("clone", url, dir)
+	return err
+}
+
+// RemoteURL returns the URL of remote, or an empty string when there is no
+// such remote.
+func (g *Git) RemoteURL(remote string) (string, error) {
+	return g.config("remote." + remote + ".url")
+}
+
+// AddRemote adds a remote called name and fetches it. The remote is removed
+// again when it can't be fetched.
+func (g *Git) AddRemote(name, url string) error {
+	if _, err := g.run("remote", "add", name, url); err != nil {
+		return err
+	}
+	if err := g.Fetch(name); err != nil {
+		g.run("remote", "remove", name)
+		return err
+	}
+	return nil
+}
+
+// LsRemote checks that the repository at url can be read. git isn't allowed
+// to prompt for credentials, which GitHub asks for when there is no such
+// repository.
+func (g *Git) LsRemote(url string) error {
+	_, err := g.runEnv([]string{"GIT_TERMINAL_PROMPT=0"}, "ls-remote", url)
+	return err
+}
+
+// RenameRemote renames a remote, along with its remote-tracking branches.
+func (g *Git) RenameRemote(from, to string) error {
+	_, err := g.run("remote", "rename", from, to)
+	return err
+}
@@ -0,0 +1,151 @@
+package git_test
+
+import (
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/tjgurwara99/ghcli/git"
+)
+
+// newTestRepo creates a repository with a single commit on main, cloned from
+// a bare repository which acts as its origin. It returns the clone's path.
+func newTestRepo(t *testing.T) string {
+	t.Helper()
+	t.Setenv("GIT_AUTHOR_NAME", "Test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+	dir := t.TempDir()
+	origin := filepath.Join(dir, "origin.git")
+	clone := filepath.Join(dir, "clone")
+	gitRun(t, dir, "init", "--bare", "-b", "main", origin)
+	gitRun(t, dir, "clone", origin, clone)
+	gitRun(t, clone, "checkout", "-b", "main")
+	gitRun(t, clone, "commit", "--allow-empty", "-m", "Initial commit")
+	gitRun(t, clone, "push", "-u", "origin", "main")
+	return clone
+}
+
+func gitRun(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+}
+
+func TestGit_BranchAndUpstream(t *testing.T) {
+	dir := newTestRepo(t)
+	g := git.New(dir)
+	gitRun(t, dir, "checkout", "-b", "feature")
+	branch, err := g.CurrentBranch()
+	if err != nil || branch != "feature" {
+		t.Fatalf("CurrentBranch() = %q, %v, want feature", branch, err)
+	}
+	remote, remoteBranch, err := g.Upstream("feature")
+	if err != nil || remote != "" || remoteBranch != "" {
+		t.Errorf("Upstream() of an unpushed branch = %q, %q, %v", remote, remoteBranch, err)
+	}
+	if err := g.Push("origin", "feature", true); err != nil {
+		t.Fatalf("Push() error = %v", err)
+	}
+	remote, remoteBranch, err = g.Upstream("feature")
+	if err != nil || remote != "origin" || remoteBranch != "feature" {
+		t.Errorf("Upstream() = %q, %q, %v, want origin, feature", remote, remoteBranch, err)
+	}
+	local, err := g.RevParse("HEAD")
+	if err != nil {
+		t.Fatalf("RevParse() error = %v", err)
+	}
+	if pushed, _ := g.RevParse("origin/feature"); pushed != local {
+		t.Errorf("RevParse(origin/feature) = %q, want %q", pushed, local)
+	}
+}
+
+func TestGit_Commits(t *testing.T) {
+	dir := newTestRepo(t)
+	gitRun(t, dir, "checkout", "-b", "feature")
+	gitRun(t, dir, "commit", "--allow-empty", "-m", "Add heap sort", "-m", "It's quick.")
+	gitRun(t, dir, "commit", "--allow-empty", "-m", "Fix typo")
+	got, err := git.New(dir).Commits("main", "HEAD")
+	if err != nil {
+		t.Fatalf("Commits() error = %v", err)
+	}
+	var subjects, bodies []string
+	for _, commit := range got {
+		subjects = append(subjects, commit.Subject)
+		bodies = append(bodies, commit.Body)
+	}
+	if !reflect.DeepEqual(subjects, []string{"Add heap sort", "Fix typo"}) {
+		t.Errorf("Commits() subjects = %q", subjects)
+	}
+	if !reflect.DeepEqual(bodies, []string{"It's quick.", ""}) {
+		t.Errorf("Commits() bodies = %q", bodies)
+	}
+}
+
+func TestGit_CheckoutNewBranch(t *testing.T) {
+	dir := newTestRepo(t)
+	g := git.New(dir)
+	if g.BranchExists("feature") {
+		t.Fatalf("BranchExists(feature) = true before creating it")
+	}
+	if err := g.CheckoutNewBranch("feature", "main"); err != nil {
+		t.Fatalf("CheckoutNewBranch() error = %v", err)
+	}
+	if !g.BranchExists("feature") {
+		t.Errorf("BranchExists(feature) = false after creating it")
+	}
+	if err := g.SetUpstream("feature", "origin", "refs/pull/1/head"); err != nil {
+		t.Fatalf("SetUpstream() error = %v", err)
+	}
+	remote, remoteBranch, err := g.Upstream("feature")
+	if err != nil || remote != "origin" || remoteBranch != "refs/pull/1/head" {
+		t.Errorf("Upstream() = %q, %q, %v, want origin, refs/pull/1/head", remote, remoteBranch, err)
+	}
+	dirty, err := g.HasLocalChanges()
+	if err != nil || dirty {
+		t.Errorf("HasLocalChanges() = %v, %v, want false", dirty, err)
+	}
+}
+
+func TestGit_Remotes(t *testing.T) {
+	dir := newTestRepo(t)
+	origin := filepath.Join(filepath.Dir(dir), "origin.git")
+	g := git.New(filepath.Dir(dir))
+	if err := g.Clone(origin, "copy"); err != nil {
+		t.Fatalf("Clone() error = %v", err)
+	}
+	g = g.In("copy")
+	if err := g.RenameRemote("origin", "upstream"); err != nil {
+		t.Fatalf("RenameRemote() error = %v", err)
+	}
+	if err := g.AddRemote("origin", dir); err != nil {
+		t.Fatalf("AddRemote() error = %v", err)
+	}
+	if url, err := g.RemoteURL("upstream"); err != nil || url != origin {
+		t.Errorf("RemoteURL(upstream) = %q, %v, want %q", url, err, origin)
+	}
+	if url, err := g.RemoteURL("origin"); err != nil || url != dir {
+		t.Errorf("RemoteURL(origin) = %q, %v, want %q", url, err, dir)
+	}
+	if url, err := g.RemoteURL("fork"); err != nil || url != "" {
+		t.Errorf("RemoteURL(fork) = %q, %v, want no URL", url, err)
+	}
+	missing := filepath.Join(filepath.Dir(dir), "missing.git")
+	if err := g.LsRemote(origin); err != nil {
+		t.Errorf("LsRemote(origin) error = %v", err)
+	}
+	if err := g.LsRemote(missing); err == nil {
+		t.Errorf("LsRemote(missing) succeeded")
+	}
+	if err := g.AddRemote("fork", missing); err == nil {
+		t.Errorf("AddRemote(fork) of a missing repository succeeded")
+	}
+	if url, err := g.RemoteURL("fork"); err != nil || url != "" {
+		t.Errorf("RemoteURL(fork) = %q, %v, want the failed remote removed", url, err)
+	}
+}
@@ -5,10 +5,13 @@ go 1.18
 require (
 	github.com/google/go-github v17.0.0+incompatible
 	github.com/spf13/cobra v1.4.0
+	golang.org/x/crypto v0.8.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/google/go-querystring v1.1.0 // indirect
 	github.com/inconshreveable/mousetrap v1.0.0 // indirect
 	github.com/spf13/pflag v1.0.5 // indirect
+	golang.org/x/sys v0.7.0 // indirect
 )